@@ -0,0 +1,153 @@
+package goresult
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// contextError wraps an error with a human readable message describing what was being done.
+type contextError struct {
+	msg string
+	err error
+}
+
+func (e *contextError) Error() string {
+	return e.msg + ": " + e.err.Error()
+}
+
+func (e *contextError) Unwrap() error {
+	return e.err
+}
+
+// Format implements fmt.Formatter, %+v prints the whole chain as "caused by:" lines.
+func (e *contextError) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('+') {
+		io.WriteString(f, ErrorChain(e))
+		return
+	}
+
+	fmt.Fprintf(f, fmt.FormatString(f, verb), e.Error())
+}
+
+// Context wraps the error of r with msg, leaving an Ok untouched.
+// The wrapped error keeps errors.Is and errors.As working on the original error.
+// example:
+//
+//	result := Context(From(os.ReadFile("config.json")), "load config")
+//	fmt.Println(result.Error())
+//
+// // Output: load config: open config.json: no such file or directory
+func Context[T any](r Result[T], msg string) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+
+	return &result[T]{error: &contextError{msg: msg, err: r.Error()}, frames: framesOf(r)}
+}
+
+// WithContext is like Context, but only calls f to build the message if r is Error.
+// example:
+//
+//	result := WithContext(From(os.ReadFile(path)), func() string {
+//		return "load " + path
+//	})
+func WithContext[T any](r Result[T], f func() string) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+
+	return Context(r, f())
+}
+
+// WithContextf is like Context, but formats the message according to format.
+// example:
+//
+//	result := WithContextf(From(os.ReadFile(path)), "load %s", path)
+func WithContextf[T any](r Result[T], format string, args ...any) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+
+	return Context(r, fmt.Sprintf(format, args...))
+}
+
+// ErrorChain formats err and its causes one per line, each cause prefixed with "caused by: ".
+// Errors wrapped by Context print only their own message, and every error joined by a MultiError
+// or errors.Join is printed on an indented "- " line.
+// example:
+//
+//	r := Context(Error[int](os.ErrNotExist), "load config")
+//	fmt.Println(ErrorChain(r.Error()))
+//
+// // Output:
+// // load config
+// // caused by: file does not exist
+func ErrorChain(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(ownMessage(err))
+	writeErrorChain(&sb, err)
+
+	return sb.String()
+}
+
+// writeErrorChain writes the causes of err as "caused by:" lines.
+// The errors joined by an error such as a MultiError are written as indented "- " lines, each followed by its own causes.
+func writeErrorChain(w io.Writer, err error) {
+	writeCauses(w, err, "")
+}
+
+func writeCauses(w io.Writer, err error, indent string) {
+	for {
+		if members := unwrapMulti(err); members != nil {
+			for _, member := range members {
+				fmt.Fprintf(w, "\n%s- %s", indent, ownMessage(member))
+				// The message of an ItemError already ends with the message of its cause.
+				if ie, ok := member.(*ItemError); ok {
+					member = ie.Err
+				}
+				writeCauses(w, member, indent+"  ")
+			}
+			return
+		}
+
+		if err = errors.Unwrap(err); err == nil {
+			return
+		}
+		fmt.Fprintf(w, "\n%scaused by: %s", indent, ownMessage(err))
+	}
+}
+
+// unwrapMulti returns the errors joined by err, nil if err does not join several errors.
+func unwrapMulti(err error) []error {
+	if multi, ok := err.(interface{ Unwrap() []error }); ok {
+		return multi.Unwrap()
+	}
+
+	return nil
+}
+
+// multiCause returns the errors joined by the first error in the chain of err that joins several errors.
+func multiCause(err error) []error {
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		if members := unwrapMulti(e); members != nil {
+			return members
+		}
+	}
+
+	return nil
+}
+
+// ownMessage returns the message err adds to its chain.
+func ownMessage(err error) string {
+	if ce, ok := err.(*contextError); ok {
+		return ce.msg
+	}
+
+	return err.Error()
+}
@@ -0,0 +1,70 @@
+package goresult
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+)
+
+func Test_Context(t *testing.T) {
+	assert.Equal(t, Ok(1), Context(Ok(1), "load config"))
+
+	r := Context(Error[int](os.ErrNotExist), "load config")
+	assert.EqualError(t, r.Error(), "load config: file does not exist")
+	assert.ErrorIs(t, r.Error(), os.ErrNotExist)
+}
+
+func Test_Context_As(t *testing.T) {
+	r := Context(Error[int](&PanicError{Value: "boom"}), "run task")
+
+	var pe *PanicError
+	assert.True(t, errors.As(r.Error(), &pe))
+	assert.Equal(t, "boom", pe.Value)
+}
+
+func Test_WithContext(t *testing.T) {
+	called := false
+	f := func() string {
+		called = true
+		return "load config"
+	}
+
+	assert.Equal(t, Ok(1), WithContext(Ok(1), f))
+	assert.False(t, called)
+
+	r := WithContext(Error[int]("error"), f)
+	assert.True(t, called)
+	assert.EqualError(t, r.Error(), "load config: error")
+}
+
+func Test_WithContextf(t *testing.T) {
+	assert.Equal(t, Ok(1), WithContextf(Ok(1), "load %s", "config"))
+
+	r := WithContextf(Error[int](os.ErrNotExist), "load %s", "config")
+	assert.EqualError(t, r.Error(), "load config: file does not exist")
+	assert.ErrorIs(t, r.Error(), os.ErrNotExist)
+}
+
+func Test_ErrorChain(t *testing.T) {
+	r := Context(Context(Error[int](os.ErrNotExist), "read file"), "load config")
+
+	assert.Equal(t, "load config\ncaused by: read file\ncaused by: file does not exist", ErrorChain(r.Error()))
+	assert.Equal(t, "load config\ncaused by: read file\ncaused by: file does not exist", fmt.Sprintf("%+v", r.Error()))
+	assert.Equal(t, "load config: read file: file does not exist", fmt.Sprintf("%v", r.Error()))
+	assert.Equal(t, "", ErrorChain(nil))
+
+	assert.Equal(t,
+		"Error(load config: read file: file does not exist)\ncaused by: read file\ncaused by: file does not exist",
+		fmt.Sprintf("%+v", r))
+}
+
+func Test_ErrorChain_MultiError(t *testing.T) {
+	r := Context(CollectAll([]Result[int]{Error[int](Context(Error[int](os.ErrNotExist), "read file").Error()), Error[int]("timeout")}), "load")
+
+	assert.Equal(t,
+		"load\ncaused by: [0]: read file: file does not exist; [1]: timeout\n- [0]: read file: file does not exist\n  caused by: file does not exist\n- [1]: timeout",
+		ErrorChain(r.Error()))
+	assert.Equal(t, "a\nb\n- a\n- b", ErrorChain(errors.Join(errors.New("a"), errors.New("b"))))
+}
@@ -0,0 +1,68 @@
+package goresult
+
+// Scope is the handle passed to the block of a Do call.
+// It is used by Must to short-circuit the block on the first Error.
+type Scope struct {
+	// pointers to zero-sized values may compare equal, so Scope must not be empty
+	_ byte
+}
+
+// scopeExit is the sentinel panic raised by Must, recovered only by the Do call owning the scope.
+type scopeExit struct {
+	scope  *Scope
+	err    error
+	frames []uintptr
+}
+
+// String describes a scopeExit that escaped its Do call, e.g. when Must is called after Do has returned.
+func (e *scopeExit) String() string {
+	return "goresult: Must called outside its Do block: " + e.err.Error()
+}
+
+// Do runs f and returns Ok with its return value.
+// If Must is called inside f with an Error result, f stops at that point and Do returns that Error.
+// Other panics are not recovered and propagate to the caller.
+// example:
+//
+//	result := Do(func(s *Scope) int {
+//		a := Must(s, From(strconv.Atoi("1")))
+//		b := Must(s, From(strconv.Atoi("2")))
+//		return a + b
+//	})
+//	fmt.Println(result.Unwrap())
+//
+// // Output: 3
+func Do[T any](f func(s *Scope) T) (r Result[T]) {
+	s := &Scope{}
+
+	defer func() {
+		if v := recover(); v != nil {
+			exit, ok := v.(*scopeExit)
+			if !ok || exit.scope != s {
+				panic(v)
+			}
+
+			r = &result[T]{error: exit.err, frames: exit.frames}
+		}
+	}()
+
+	return Ok(f(s))
+}
+
+// Must returns the value of r if it is Ok.
+// If r is Error, the block of the Do call owning s returns immediately with that error.
+// example:
+//
+//	Do(func(s *Scope) int {
+//		v := Must(s, Error[int]("something went wrong"))
+//		return v // never reached
+//	})
+//
+// // Output: Error(something went wrong)
+func Must[T any](s *Scope, r Result[T]) T {
+	if r.IsError() {
+		panic(&scopeExit{scope: s, err: r.Error(), frames: framesOf(r)})
+	}
+
+	return r.Value()
+}
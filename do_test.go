@@ -0,0 +1,91 @@
+package goresult
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func Test_Do(t *testing.T) {
+	r := Do(func(s *Scope) int {
+		a := Must(s, From(strconv.Atoi("1")))
+		b := Must(s, From(strconv.Atoi("2")))
+		return a + b
+	})
+
+	assert.Equal(t, Ok(3), r)
+}
+
+func Test_Do_Error(t *testing.T) {
+	err := fmt.Errorf("error")
+	reached := false
+
+	r := Do(func(s *Scope) int {
+		_ = Must(s, Error[int](err))
+		reached = true
+		return 1
+	})
+
+	assert.Equal(t, Error[int](err), r)
+	assert.False(t, reached)
+}
+
+func Test_Do_Panic(t *testing.T) {
+	assert.PanicsWithValue(t, "boom", func() {
+		Do(func(s *Scope) int {
+			panic("boom")
+		})
+	})
+}
+
+func Test_Do_Nested(t *testing.T) {
+	err := fmt.Errorf("error")
+
+	r := Do(func(outer *Scope) int {
+		inner := Do(func(_ *Scope) int {
+			return Must(outer, Error[int](err))
+		})
+		return inner.UnwrapOr(0)
+	})
+
+	assert.Equal(t, Error[int](err), r)
+}
+
+func Test_Do_MustInTry(t *testing.T) {
+	err := fmt.Errorf("error")
+
+	r := Do(func(s *Scope) int {
+		return Try(func() int { return Must(s, Error[int](err)) }).UnwrapOr(7)
+	})
+
+	assert.Equal(t, Error[int](err), r)
+}
+
+func Test_Do_MustInAsync(t *testing.T) {
+	err := fmt.Errorf("error")
+	ctx := context.Background()
+
+	r := Do(func(s *Scope) int {
+		fut := Async(ctx, func(context.Context) Result[int] {
+			return Ok(Must(s, Error[int](err)))
+		})
+		return Must(s, fut.Await(ctx)) + 1
+	})
+
+	assert.Equal(t, Error[int](err), r)
+}
+
+func Test_Do_MustOutside(t *testing.T) {
+	var scope *Scope
+	Do(func(s *Scope) int {
+		scope = s
+		return 1
+	})
+
+	defer func() {
+		assert.Equal(t, "goresult: Must called outside its Do block: error", fmt.Sprint(recover()))
+	}()
+	Must(scope, Error[int]("error"))
+}
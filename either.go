@@ -0,0 +1,197 @@
+package goresult
+
+type Either[L, R any] interface {
+	IsLeft() bool
+	IsRight() bool
+	Left() L
+	Right() R
+	ExceptLeft(msg string) L
+	ExceptRight(msg string) R
+	UnwrapLeft() L
+	UnwrapRight() R
+	InspectLeft(f func(L)) Either[L, R]
+	InspectRight(f func(R)) Either[L, R]
+	LeftOption() Option[L]
+	RightOption() Option[R]
+	Swap() Either[R, L]
+	Result() Result[R]
+}
+
+// either is a generic type that holds a value of one of two types, either Left(L) or Right(R).
+type either[L, R any] struct {
+	left  L
+	right R
+	isR   bool
+}
+
+// Left returns an either value of Left(L).
+// example:
+//
+//	Left[int, string](1)
+func Left[L, R any](value L) Either[L, R] {
+	return &either[L, R]{left: value}
+}
+
+// Right returns an either value of Right(R).
+// example:
+//
+//	Right[int]("hello")
+func Right[L, R any](value R) Either[L, R] {
+	return &either[L, R]{right: value, isR: true}
+}
+
+// EitherFromResult returns Right(T) if r is Ok, otherwise Left(error).
+// example:
+//
+//	e := EitherFromResult(Ok(1))
+//	fmt.Println(e.UnwrapRight())
+//
+// // Output: 1
+func EitherFromResult[T any](r Result[T]) Either[error, T] {
+	if r.IsOk() {
+		return Right[error](r.Value())
+	}
+
+	return Left[error, T](r.Error())
+}
+
+// Fold calls onLeft with the value if e is Left, otherwise calls onRight, and returns the result.
+// example:
+//
+//	s := Fold(Left[int, string](1), strconv.Itoa, strings.ToUpper)
+//	fmt.Println(s)
+//
+// // Output: 1
+func Fold[L, R, U any](e Either[L, R], onLeft func(L) U, onRight func(R) U) U {
+	if e.IsRight() {
+		return onRight(e.Right())
+	}
+
+	return onLeft(e.Left())
+}
+
+// MapLeft maps an Either[L, R] to Either[U, R] by applying f to a Left value, leaving a Right untouched.
+func MapLeft[L, R, U any](e Either[L, R], f func(L) U) Either[U, R] {
+	if e.IsRight() {
+		return Right[U](e.Right())
+	}
+
+	return Left[U, R](f(e.Left()))
+}
+
+// MapRight maps an Either[L, R] to Either[L, U] by applying f to a Right value, leaving a Left untouched.
+// example:
+//
+//	e := MapRight(Right[error]("1"), strings.ToUpper)
+func MapRight[L, R, U any](e Either[L, R], f func(R) U) Either[L, U] {
+	if e.IsRight() {
+		return Right[L](f(e.Right()))
+	}
+
+	return Left[L, U](e.Left())
+}
+
+// IsLeft returns true if the either is a Left value.
+func (e *either[L, R]) IsLeft() bool {
+	return !e.isR
+}
+
+// IsRight returns true if the either is a Right value.
+func (e *either[L, R]) IsRight() bool {
+	return e.isR
+}
+
+// Left returns the Left value, or the zero value of L if the either is Right.
+func (e *either[L, R]) Left() L {
+	return e.left
+}
+
+// Right returns the Right value, or the zero value of R if the either is Left.
+func (e *either[L, R]) Right() R {
+	return e.right
+}
+
+// ExceptLeft returns the Left value, otherwise it panics with the given message.
+func (e *either[L, R]) ExceptLeft(msg string) L {
+	if e.IsRight() {
+		unwrapValueFailed(msg, e.right)
+	}
+
+	return e.left
+}
+
+// ExceptRight returns the Right value, otherwise it panics with the given message.
+func (e *either[L, R]) ExceptRight(msg string) R {
+	if e.IsLeft() {
+		unwrapValueFailed(msg, e.left)
+	}
+
+	return e.right
+}
+
+// UnwrapLeft returns the Left value, otherwise it panics.
+func (e *either[L, R]) UnwrapLeft() L {
+	return e.ExceptLeft("called `either.UnwrapLeft()` on a `right` value")
+}
+
+// UnwrapRight returns the Right value, otherwise it panics.
+func (e *either[L, R]) UnwrapRight() R {
+	return e.ExceptRight("called `either.UnwrapRight()` on a `left` value")
+}
+
+// InspectLeft calls f with the value if the either is Left.
+func (e *either[L, R]) InspectLeft(f func(L)) Either[L, R] {
+	if e.IsLeft() {
+		f(e.left)
+	}
+
+	return e
+}
+
+// InspectRight calls f with the value if the either is Right.
+func (e *either[L, R]) InspectRight(f func(R)) Either[L, R] {
+	if e.IsRight() {
+		f(e.right)
+	}
+
+	return e
+}
+
+// LeftOption returns Some(L) if the either is Left, otherwise None.
+func (e *either[L, R]) LeftOption() Option[L] {
+	if e.IsLeft() {
+		return Some(e.left)
+	}
+
+	return None[L]()
+}
+
+// RightOption returns Some(R) if the either is Right, otherwise None.
+func (e *either[L, R]) RightOption() Option[R] {
+	if e.IsRight() {
+		return Some(e.right)
+	}
+
+	return None[R]()
+}
+
+// Swap returns Right(L) if the either is Left, otherwise Left(R).
+func (e *either[L, R]) Swap() Either[R, L] {
+	return &either[R, L]{left: e.right, right: e.left, isR: !e.isR}
+}
+
+// Result returns Ok(R) if the either is Right, otherwise Error(L).
+// The Left value is converted the same way as Error converts its argument.
+// example:
+//
+//	r := Left[string, int]("something went wrong").Result()
+//	fmt.Println(r.Error())
+//
+// // Output: something went wrong
+func (e *either[L, R]) Result() Result[R] {
+	if e.IsRight() {
+		return Ok(e.right)
+	}
+
+	return newError[R](e.left, 1)
+}
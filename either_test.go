@@ -0,0 +1,87 @@
+package goresult
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func Test_Either_Left(t *testing.T) {
+	e := Left[int, string](1)
+
+	assert.True(t, e.IsLeft())
+	assert.False(t, e.IsRight())
+	assert.Equal(t, 1, e.Left())
+	assert.Equal(t, "", e.Right())
+	assert.Equal(t, 1, e.UnwrapLeft())
+	assert.Panics(t, func() { e.UnwrapRight() })
+	assert.Equal(t, Some(1), e.LeftOption())
+	assert.Equal(t, None[string](), e.RightOption())
+}
+
+func Test_Either_UnwrapNil(t *testing.T) {
+	assert.PanicsWithError(t, "called `either.UnwrapRight()` on a `left` value: <nil>", func() {
+		Left[error, int](nil).UnwrapRight()
+	})
+}
+
+func Test_Either_Right(t *testing.T) {
+	e := Right[int]("hello")
+
+	assert.False(t, e.IsLeft())
+	assert.True(t, e.IsRight())
+	assert.Equal(t, "hello", e.Right())
+	assert.Equal(t, "hello", e.UnwrapRight())
+	assert.Equal(t, "hello", e.ExceptRight("error"))
+	assert.Panics(t, func() { e.UnwrapLeft() })
+	assert.Equal(t, None[int](), e.LeftOption())
+	assert.Equal(t, Some("hello"), e.RightOption())
+}
+
+func Test_Either_Inspect(t *testing.T) {
+	var left int
+	var right string
+
+	Left[int, string](1).InspectLeft(func(v int) { left = v }).InspectRight(func(v string) { right = v })
+	assert.Equal(t, 1, left)
+	assert.Equal(t, "", right)
+
+	Right[int]("hello").InspectLeft(func(v int) { left = 2 }).InspectRight(func(v string) { right = v })
+	assert.Equal(t, 1, left)
+	assert.Equal(t, "hello", right)
+}
+
+func Test_Either_Fold(t *testing.T) {
+	assert.Equal(t, "1", Fold(Left[int, string](1), strconv.Itoa, strings.ToUpper))
+	assert.Equal(t, "HELLO", Fold(Right[int]("hello"), strconv.Itoa, strings.ToUpper))
+}
+
+func Test_Either_Map(t *testing.T) {
+	assert.Equal(t, Left[string, string]("1"), MapLeft(Left[int, string](1), strconv.Itoa))
+	assert.Equal(t, Right[string]("hello"), MapLeft(Right[int]("hello"), strconv.Itoa))
+
+	assert.Equal(t, Right[int]("HELLO"), MapRight(Right[int]("hello"), strings.ToUpper))
+	assert.Equal(t, Left[int, string](1), MapRight(Left[int, string](1), strings.ToUpper))
+}
+
+func Test_Either_Swap(t *testing.T) {
+	assert.Equal(t, Right[string](1), Left[int, string](1).Swap())
+	assert.Equal(t, Left[string, int]("hello"), Right[int]("hello").Swap())
+}
+
+func Test_Either_Result(t *testing.T) {
+	err := fmt.Errorf("error")
+
+	assert.Equal(t, Ok(1), Right[error](1).Result())
+	assert.Equal(t, Error[int](err), Left[error, int](err).Result())
+	assert.EqualError(t, Left[string, int]("error").Result().Error(), "error")
+}
+
+func Test_EitherFromResult(t *testing.T) {
+	err := fmt.Errorf("error")
+
+	assert.Equal(t, Right[error](1), EitherFromResult(Ok(1)))
+	assert.Equal(t, Left[error, int](err), EitherFromResult(Error[int](err)))
+}
@@ -0,0 +1,152 @@
+package goresult
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"runtime"
+)
+
+// String returns Ok(value) or Error(error).
+// example:
+//
+//	fmt.Println(Ok(1))
+//
+// // Output: Ok(1)
+//
+//	fmt.Println(Error[int]("something went wrong"))
+//
+// // Output: Error(something went wrong)
+func (r *result[T]) String() string {
+	if r.IsOk() {
+		return fmt.Sprintf("Ok(%v)", r.value)
+	}
+
+	return fmt.Sprintf("Error(%v)", r.error)
+}
+
+// Format implements fmt.Formatter.
+//   - %v and %s print Ok(value) or Error(error).
+//   - %+v additionally prints the error chain as "caused by:" lines, any captured panic stack and the captured frames.
+//   - %#v prints a Go-syntax representation, such as goresult.Ok[int](1).
+//   - other verbs are applied to the value of an Ok, such as %x printing Ok(ff); an Error is printed as with %v.
+func (r *result[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		if r.IsOk() {
+			fmt.Fprintf(f, "goresult.Ok[%s](%#v)", typeName[T](), r.value)
+		} else {
+			fmt.Fprintf(f, "goresult.Error[%s](%#v)", typeName[T](), r.error)
+		}
+	case verb == 'v' && f.Flag('+'):
+		io.WriteString(f, r.String())
+		if r.IsError() {
+			writeErrorDetail(f, r.error)
+			writeFrames(f, r.Frames())
+		}
+	case verb == 'v' || verb == 's' || r.IsError():
+		io.WriteString(f, r.String())
+	case verb == 'q':
+		fmt.Fprintf(f, "%q", r.String())
+	default:
+		fmt.Fprintf(f, "Ok(%s)", fmt.Sprintf(fmt.FormatString(f, verb), r.value))
+	}
+}
+
+// String returns Some(value) or None.
+// example:
+//
+//	fmt.Println(Some(1))
+//	// Output: Some(1)
+//
+//	fmt.Println(None[int]())
+//	// Output: None
+func (opt *option[T]) String() string {
+	if opt.IsSome() {
+		return fmt.Sprintf("Some(%v)", opt.value)
+	}
+
+	return "None"
+}
+
+// Format implements fmt.Formatter.
+//   - %v, %+v and %s print Some(value) or None.
+//   - %#v prints a Go-syntax representation, such as goresult.Some[int](1).
+//   - other verbs are applied to the value, such as %x printing Some(ff).
+func (opt *option[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		if opt.IsSome() {
+			fmt.Fprintf(f, "goresult.Some[%s](%#v)", typeName[T](), opt.value)
+		} else {
+			fmt.Fprintf(f, "goresult.None[%s]()", typeName[T]())
+		}
+	case verb == 'v' || verb == 's' || opt.IsNone():
+		io.WriteString(f, opt.String())
+	case verb == 'q':
+		fmt.Fprintf(f, "%q", opt.String())
+	default:
+		fmt.Fprintf(f, "Some(%s)", fmt.Sprintf(fmt.FormatString(f, verb), opt.value))
+	}
+}
+
+// String returns Left(value) or Right(value).
+// example:
+//
+//	fmt.Println(Left[int, string](1))
+//	// Output: Left(1)
+//
+//	fmt.Println(Right[int]("hello"))
+//	// Output: Right(hello)
+func (e *either[L, R]) String() string {
+	if e.IsRight() {
+		return fmt.Sprintf("Right(%v)", e.right)
+	}
+
+	return fmt.Sprintf("Left(%v)", e.left)
+}
+
+// Format implements fmt.Formatter.
+//   - %v, %+v and %s print Left(value) or Right(value).
+//   - %#v prints a Go-syntax representation, such as goresult.Left[int, string](1).
+//   - other verbs are applied to the value, such as %x printing Left(ff).
+func (e *either[L, R]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		if e.IsRight() {
+			fmt.Fprintf(f, "goresult.Right[%s, %s](%#v)", typeName[L](), typeName[R](), e.right)
+		} else {
+			fmt.Fprintf(f, "goresult.Left[%s, %s](%#v)", typeName[L](), typeName[R](), e.left)
+		}
+	case verb == 'v' || verb == 's':
+		io.WriteString(f, e.String())
+	case verb == 'q':
+		fmt.Fprintf(f, "%q", e.String())
+	case e.IsRight():
+		fmt.Fprintf(f, "Right(%s)", fmt.Sprintf(fmt.FormatString(f, verb), e.right))
+	default:
+		fmt.Fprintf(f, "Left(%s)", fmt.Sprintf(fmt.FormatString(f, verb), e.left))
+	}
+}
+
+// writeErrorDetail writes the chain of err as "caused by:" lines, followed by any captured panic stack.
+func writeErrorDetail(w io.Writer, err error) {
+	writeErrorChain(w, err)
+
+	var pe *PanicError
+	if errors.As(err, &pe) && len(pe.Stack) > 0 {
+		fmt.Fprintf(w, "\n%s", pe.Stack)
+	}
+}
+
+// writeFrames writes frames in the same layout as a goroutine stack trace.
+func writeFrames(w io.Writer, frames []runtime.Frame) {
+	for _, frame := range frames {
+		fmt.Fprintf(w, "\n%s\n\t%s:%d", frame.Function, frame.File, frame.Line)
+	}
+}
+
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
@@ -0,0 +1,76 @@
+package goresult
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_Result_String(t *testing.T) {
+	assert.Equal(t, "Ok(1)", Ok(1).(fmt.Stringer).String())
+	assert.Equal(t, "Error(error)", Error[int]("error").(fmt.Stringer).String())
+}
+
+func Test_Result_Format(t *testing.T) {
+	assert.Equal(t, "Ok(1)", fmt.Sprintf("%v", Ok(1)))
+	assert.Equal(t, "Ok(hello)", fmt.Sprintf("%s", Ok("hello")))
+	assert.Equal(t, `"Ok(hello)"`, fmt.Sprintf("%q", Ok("hello")))
+	assert.Equal(t, "Ok(ff)", fmt.Sprintf("%x", Ok(255)))
+	assert.Equal(t, "Ok(1)", fmt.Sprintf("%+v", Ok(1)))
+	assert.Equal(t, "Error(error)", fmt.Sprintf("%v", Error[int]("error")))
+	assert.Equal(t, "Error(error)", fmt.Sprintf("%d", Error[int]("error")))
+	assert.Equal(t, "Error(error)", fmt.Sprintf("%x", Error[int]("error")))
+
+	assert.Equal(t, `goresult.Ok[string]("hello")`, fmt.Sprintf("%#v", Ok("hello")))
+	assert.Equal(t, `goresult.Error[int](&errors.errorString{s:"error"})`, fmt.Sprintf("%#v", Error[int](errors.New("error"))))
+}
+
+func Test_Result_Format_Chain(t *testing.T) {
+	base := errors.New("not found")
+	err := fmt.Errorf("load user: %w", fmt.Errorf("query: %w", base))
+
+	assert.Equal(t,
+		"Error(load user: query: not found)\ncaused by: query: not found\ncaused by: not found",
+		fmt.Sprintf("%+v", Error[int](err)))
+}
+
+func Test_Result_Format_Stack(t *testing.T) {
+	r := Try(func() int { panic("boom") })
+
+	assert.Contains(t, fmt.Sprintf("%+v", r), "Error(panic: boom)\n")
+	assert.Contains(t, fmt.Sprintf("%+v", r), "goroutine")
+	assert.Equal(t, "Error(panic: boom)", fmt.Sprintf("%v", r))
+}
+
+func Test_Option_String(t *testing.T) {
+	assert.Equal(t, "Some(1)", Some(1).(fmt.Stringer).String())
+	assert.Equal(t, "None", None[int]().(fmt.Stringer).String())
+}
+
+func Test_Option_Format(t *testing.T) {
+	assert.Equal(t, "Some(1)", fmt.Sprintf("%v", Some(1)))
+	assert.Equal(t, "Some(1)", fmt.Sprintf("%+v", Some(1)))
+	assert.Equal(t, "Some(ff)", fmt.Sprintf("%x", Some(255)))
+	assert.Equal(t, "None", fmt.Sprintf("%v", None[int]()))
+	assert.Equal(t, "None", fmt.Sprintf("%x", None[int]()))
+
+	assert.Equal(t, "goresult.Some[int](1)", fmt.Sprintf("%#v", Some(1)))
+	assert.Equal(t, "goresult.None[int]()", fmt.Sprintf("%#v", None[int]()))
+}
+
+func Test_Either_String(t *testing.T) {
+	assert.Equal(t, "Left(1)", Left[int, string](1).(fmt.Stringer).String())
+	assert.Equal(t, "Right(hello)", Right[int]("hello").(fmt.Stringer).String())
+}
+
+func Test_Either_Format(t *testing.T) {
+	assert.Equal(t, "Left(1)", fmt.Sprintf("%v", Left[int, string](1)))
+	assert.Equal(t, "Right(hello)", fmt.Sprintf("%+v", Right[int]("hello")))
+	assert.Equal(t, `"Right(hello)"`, fmt.Sprintf("%q", Right[int]("hello")))
+	assert.Equal(t, "Left(ff)", fmt.Sprintf("%x", Left[int, string](255)))
+	assert.Equal(t, "Left(<nil>)", fmt.Sprintf("%v", Left[error, int](nil)))
+
+	assert.Equal(t, "goresult.Left[int, string](1)", fmt.Sprintf("%#v", Left[int, string](1)))
+	assert.Equal(t, `goresult.Right[int, string]("hello")`, fmt.Sprintf("%#v", Right[int]("hello")))
+}
@@ -0,0 +1,81 @@
+package goresult
+
+import (
+	"runtime"
+	"sync/atomic"
+)
+
+// maxFrames is the maximum number of caller frames captured for an Error.
+const maxFrames = 32
+
+var frameCapture atomic.Bool
+
+// SetFrameCapture enables or disables capturing caller frames for every Error, OkOr and OkOrElse call.
+// It is disabled by default, so creating an Error costs nothing extra in production.
+// example:
+//
+//	SetFrameCapture(true)
+//	result := Error[int]("something went wrong")
+//	fmt.Printf("%+v", result)
+func SetFrameCapture(enabled bool) {
+	frameCapture.Store(enabled)
+}
+
+// ErrorWithFrames returns a result that is Error and always captures the caller frames,
+// regardless of SetFrameCapture.
+// example:
+//
+//	ErrorWithFrames[int](errors.New("something went wrong"))
+func ErrorWithFrames[T any](err interface{}) Result[T] {
+	return &result[T]{error: covertError(err), frames: callers(1)}
+}
+
+// newError returns a result that is Error, capturing the caller frames if enabled.
+// skip is the number of frames above newError's caller to leave out.
+func newError[T any](err interface{}, skip int) Result[T] {
+	r := &result[T]{error: covertError(err)}
+	if frameCapture.Load() {
+		r.frames = callers(skip + 1)
+	}
+
+	return r
+}
+
+// errorOf returns the Error of r as a Result[U], keeping the frames captured when r was created.
+func errorOf[U, T any](r Result[T]) Result[U] {
+	return &result[U]{error: r.Error(), frames: framesOf(r)}
+}
+
+func framesOf[T any](r Result[T]) []uintptr {
+	if r, ok := r.(*result[T]); ok {
+		return r.frames
+	}
+
+	return nil
+}
+
+// callers returns the program counters of the calling stack, skipping callers itself and skip more frames.
+func callers(skip int) []uintptr {
+	pcs := make([]uintptr, maxFrames)
+	n := runtime.Callers(skip+2, pcs)
+
+	return pcs[:n]
+}
+
+func resolveFrames(pcs []uintptr) []runtime.Frame {
+	if len(pcs) == 0 {
+		return nil
+	}
+
+	frames := make([]runtime.Frame, 0, len(pcs))
+	iter := runtime.CallersFrames(pcs)
+	for {
+		frame, more := iter.Next()
+		frames = append(frames, frame)
+		if !more {
+			break
+		}
+	}
+
+	return frames
+}
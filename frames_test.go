@@ -0,0 +1,63 @@
+package goresult
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+const testFramesFunc = "github.com/siriusa51/goresult.Test_"
+
+func withFrameCapture(t *testing.T) {
+	SetFrameCapture(true)
+	t.Cleanup(func() { SetFrameCapture(false) })
+}
+
+func Test_Frames_Disabled(t *testing.T) {
+	assert.Nil(t, Error[int]("error").Frames())
+	assert.Nil(t, Ok(1).Frames())
+}
+
+func Test_Frames_Enabled(t *testing.T) {
+	withFrameCapture(t)
+
+	frames := Error[int]("error").Frames()
+	assert.NotEmpty(t, frames)
+	assert.Equal(t, testFramesFunc+"Frames_Enabled", frames[0].Function)
+
+	frames = None[int]().OkOr("error").Frames()
+	assert.Equal(t, testFramesFunc+"Frames_Enabled", frames[0].Function)
+
+	frames = None[int]().OkOrElse(func() error { return fmt.Errorf("error") }).Frames()
+	assert.Equal(t, testFramesFunc+"Frames_Enabled", frames[0].Function)
+
+	frames = From(strconv.Atoi("hello")).Frames()
+	assert.Equal(t, testFramesFunc+"Frames_Enabled", frames[0].Function)
+}
+
+func Test_ErrorWithFrames(t *testing.T) {
+	frames := ErrorWithFrames[int]("error").Frames()
+
+	assert.NotEmpty(t, frames)
+	assert.Equal(t, testFramesFunc+"ErrorWithFrames", frames[0].Function)
+}
+
+func Test_Frames_Propagate(t *testing.T) {
+	origin := ErrorWithFrames[int]("error")
+	line := origin.Frames()[0].Line
+
+	assert.Equal(t, line, Map(origin, strconv.Itoa).Frames()[0].Line)
+	assert.Equal(t, line, AndThen(origin, func(int) Result[string] { return Ok("") }).Frames()[0].Line)
+	assert.Equal(t, line, MapErr(origin, func(err error) error { return err }).Frames()[0].Line)
+	assert.Equal(t, line, Do(func(s *Scope) int { return Must(s, origin) }).Frames()[0].Line)
+	assert.Equal(t, line, ResOf(origin).Result().Frames()[0].Line)
+}
+
+func Test_Frames_Format(t *testing.T) {
+	r := ErrorWithFrames[int]("error")
+	frame := r.Frames()[0]
+
+	assert.Contains(t, fmt.Sprintf("%+v", r), fmt.Sprintf("\n%s\n\t%s:%d", frame.Function, frame.File, frame.Line))
+	assert.Equal(t, "Error(error)", fmt.Sprintf("%v", r))
+}
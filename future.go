@@ -0,0 +1,85 @@
+package goresult
+
+import "context"
+
+// Future is the result of an asynchronous computation, which settles into a Result once.
+type Future[T any] struct {
+	ctx    context.Context
+	done   chan struct{}
+	result Result[T]
+}
+
+// Async runs f in a new goroutine and returns a Future settling into its result.
+// If f panics, the future settles into Error(*PanicError).
+// If f calls Must on the Scope of an enclosing Do, the future settles into the error passed to Must,
+// since Do cannot recover a panic raised in another goroutine.
+// example:
+//
+//	fut := Async(ctx, func(ctx context.Context) Result[int] {
+//		return Ok(1)
+//	})
+//	fmt.Println(fut.Await(ctx).Unwrap())
+//
+// // Output: 1
+func Async[T any](ctx context.Context, f func(context.Context) Result[T]) *Future[T] {
+	fut := &Future[T]{ctx: ctx, done: make(chan struct{})}
+
+	go func() {
+		defer close(fut.done)
+		fut.result = goResult(func() Result[T] {
+			return f(ctx)
+		})
+	}()
+
+	return fut
+}
+
+// Done returns a channel that is closed when the future has settled.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Await waits for the future to settle and returns its result.
+// If ctx is done first, it returns Error(ctx.Err()) without waiting for the future.
+// example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	result := fut.Await(ctx)
+func (f *Future[T]) Await(ctx context.Context) Result[T] {
+	select {
+	case <-f.done:
+		return f.result
+	case <-ctx.Done():
+		return newError[T](ctx.Err(), 1)
+	}
+}
+
+// Then returns a Future that calls fn with the value once f settles Ok, passing an Error through untouched.
+// fn runs with the context f was started with.
+// example:
+//
+//	fut := Then(Async(ctx, fetchUser), func(ctx context.Context, u User) Result[string] {
+//		return Ok(u.Name)
+//	})
+func Then[T, U any](f *Future[T], fn func(context.Context, T) Result[U]) *Future[U] {
+	return Async(f.ctx, func(ctx context.Context) Result[U] {
+		<-f.done
+		return AndThen(f.result, func(v T) Result[U] {
+			return fn(ctx, v)
+		})
+	})
+}
+
+// Catch returns a Future that calls fn with the error once f settles Error, passing an Ok through untouched.
+// example:
+//
+//	fut := Catch(Async(ctx, fetchUser), func(err error) Result[User] {
+//		return Ok(Guest)
+//	})
+func Catch[T any](f *Future[T], fn func(error) Result[T]) *Future[T] {
+	return Async(f.ctx, func(context.Context) Result[T] {
+		<-f.done
+		return OrElse(f.result, fn)
+	})
+}
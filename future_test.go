@@ -0,0 +1,74 @@
+package goresult
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func Test_Async(t *testing.T) {
+	ctx := context.Background()
+	fut := Async(ctx, func(context.Context) Result[int] { return Ok(1) })
+
+	<-fut.Done()
+	assert.Equal(t, Ok(1), fut.Await(ctx))
+	assert.Equal(t, Ok(1), fut.Await(ctx))
+}
+
+func Test_Async_Panic(t *testing.T) {
+	ctx := context.Background()
+	r := Async(ctx, func(context.Context) Result[int] { panic("boom") }).Await(ctx)
+
+	var pe *PanicError
+	assert.True(t, errors.As(r.Error(), &pe))
+	assert.Equal(t, "boom", pe.Value)
+}
+
+func Test_Future_Await_Cancel(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	fut := Async(context.Background(), func(context.Context) Result[int] {
+		<-release
+		return Ok(1)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, fut.Await(ctx).Error(), context.DeadlineExceeded)
+}
+
+func Test_Future_Context(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	fut := Async(ctx, func(ctx context.Context) Result[int] {
+		<-ctx.Done()
+		return Error[int](ctx.Err())
+	})
+
+	cancel()
+	assert.ErrorIs(t, fut.Await(context.Background()).Error(), context.Canceled)
+}
+
+func Test_Then(t *testing.T) {
+	ctx := context.Background()
+	toString := func(_ context.Context, v int) Result[string] { return Ok(strconv.Itoa(v)) }
+
+	assert.Equal(t, Ok("1"), Then(Async(ctx, func(context.Context) Result[int] { return Ok(1) }), toString).Await(ctx))
+
+	err := fmt.Errorf("error")
+	assert.Equal(t, Error[string](err), Then(Async(ctx, func(context.Context) Result[int] { return Error[int](err) }), toString).Await(ctx))
+}
+
+func Test_Catch(t *testing.T) {
+	ctx := context.Background()
+	fallback := func(error) Result[int] { return Ok(0) }
+
+	assert.Equal(t, Ok(1), Catch(Async(ctx, func(context.Context) Result[int] { return Ok(1) }), fallback).Await(ctx))
+	assert.Equal(t, Ok(0), Catch(Async(ctx, func(context.Context) Result[int] { return Error[int]("error") }), fallback).Await(ctx))
+	assert.Equal(t, Ok(0), Catch(Async(ctx, func(context.Context) Result[int] { panic("boom") }), fallback).Await(ctx))
+}
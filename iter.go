@@ -0,0 +1,88 @@
+package goresult
+
+import "iter"
+
+// Iter returns an iterator yielding the value if the option is Some, and nothing otherwise.
+// example:
+//
+//	for v := range Some(1).Iter() {
+//		fmt.Println(v)
+//	}
+//	// Output: 1
+func (opt *option[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if opt.IsSome() {
+			yield(opt.value)
+		}
+	}
+}
+
+// Iter returns an iterator yielding the value if the result is Ok, and nothing otherwise.
+// example:
+//
+//	for v := range Ok(1).Iter() {
+//		fmt.Println(v)
+//	}
+//
+// // Output: 1
+func (r *result[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if r.IsOk() {
+			yield(r.value)
+		}
+	}
+}
+
+// SeqResults turns an iter.Seq2[T, error] into an iterator of results,
+// yielding Error(err) for every pair with a non-nil error and Ok(v) otherwise.
+// example:
+//
+//	for r := range SeqResults(rows) {
+//		fmt.Println(r)
+//	}
+func SeqResults[T any](seq iter.Seq2[T, error]) iter.Seq[Result[T]] {
+	return func(yield func(Result[T]) bool) {
+		for v, err := range seq {
+			if !yield(From(v, err)) {
+				return
+			}
+		}
+	}
+}
+
+// CollectSeq returns Ok with all values of seq, stopping at the first Error and returning it.
+// example:
+//
+//	r := CollectSeq(SeqResults(rows))
+func CollectSeq[T any](seq iter.Seq[Result[T]]) Result[[]T] {
+	values := make([]T, 0)
+	for r := range seq {
+		if r.IsError() {
+			return errorOf[[]T](r)
+		}
+		values = append(values, r.Value())
+	}
+
+	return Ok(values)
+}
+
+// FilterMapSeq calls f with each element of seq, yielding the values of the Some options and dropping every None.
+// example:
+//
+//	for v := range FilterMapSeq(slices.Values([]string{"1", "a"}), func(s string) Option[int] {
+//		return From(strconv.Atoi(s)).Option()
+//	}) {
+//		fmt.Println(v)
+//	}
+//
+// // Output: 1
+func FilterMapSeq[T, U any](seq iter.Seq[T], f func(T) Option[U]) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range seq {
+			opt := f(v)
+			if opt.IsSome() && !yield(opt.Value()) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package goresult
+
+import (
+	"github.com/stretchr/testify/assert"
+	"iter"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func testSeq2(values []string) iter.Seq2[int, error] {
+	return func(yield func(int, error) bool) {
+		for _, s := range values {
+			if !yield(strconv.Atoi(s)) {
+				return
+			}
+		}
+	}
+}
+
+func Test_Option_Iter(t *testing.T) {
+	assert.Equal(t, []int{1}, slices.Collect(Some(1).Iter()))
+	assert.Nil(t, slices.Collect(None[int]().Iter()))
+}
+
+func Test_Result_Iter(t *testing.T) {
+	assert.Equal(t, []int{1}, slices.Collect(Ok(1).Iter()))
+	assert.Nil(t, slices.Collect(Error[int]("error").Iter()))
+}
+
+func Test_SeqResults(t *testing.T) {
+	results := slices.Collect(SeqResults(testSeq2([]string{"1", "a", "2"})))
+
+	assert.Len(t, results, 3)
+	assert.Equal(t, Ok(1), results[0])
+	assert.True(t, results[1].IsError())
+	assert.Equal(t, Ok(2), results[2])
+
+	for r := range SeqResults(testSeq2([]string{"1", "2"})) {
+		assert.Equal(t, Ok(1), r)
+		break
+	}
+}
+
+func Test_CollectSeq(t *testing.T) {
+	assert.Equal(t, Ok([]int{1, 2}), CollectSeq(SeqResults(testSeq2([]string{"1", "2"}))))
+	assert.Equal(t, Ok([]int{}), CollectSeq(SeqResults(testSeq2(nil))))
+
+	pulled := 0
+	seq := func(yield func(Result[int]) bool) {
+		for _, r := range []Result[int]{Ok(1), Error[int]("error"), Ok(2)} {
+			pulled++
+			if !yield(r) {
+				return
+			}
+		}
+	}
+
+	r := CollectSeq(seq)
+	assert.EqualError(t, r.Error(), "error")
+	assert.Equal(t, 2, pulled)
+}
+
+func Test_FilterMapSeq(t *testing.T) {
+	parse := func(s string) Option[int] { return From(strconv.Atoi(s)).Option() }
+
+	assert.Equal(t, []int{1, 2}, slices.Collect(FilterMapSeq(slices.Values([]string{"1", "a", "2"}), parse)))
+
+	var first []int
+	for v := range FilterMapSeq(slices.Values([]string{"a", "1", "2"}), parse) {
+		first = append(first, v)
+		break
+	}
+	assert.Equal(t, []int{1}, first)
+	assert.Empty(t, slices.Collect(FilterMapSeq(slices.Values([]string{"a"}), parse)))
+}
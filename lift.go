@@ -0,0 +1,98 @@
+package goresult
+
+// Lift1 turns a func(A) (B, error) into a func(A) Result[B].
+// example:
+//
+//	atoi := Lift1(strconv.Atoi)
+//	fmt.Println(atoi("1").Unwrap())
+//
+// // Output: 1
+func Lift1[A, B any](f func(A) (B, error)) func(A) Result[B] {
+	return func(a A) Result[B] {
+		return From(f(a))
+	}
+}
+
+// Lift2 turns a func(A1, A2) (B, error) into a func(A1, A2) Result[B].
+// example:
+//
+//	parse := Lift2(strconv.ParseFloat)
+//	fmt.Println(parse("1.5", 64).Unwrap())
+//
+// // Output: 1.5
+func Lift2[A1, A2, B any](f func(A1, A2) (B, error)) func(A1, A2) Result[B] {
+	return func(a1 A1, a2 A2) Result[B] {
+		return From(f(a1, a2))
+	}
+}
+
+// Lift3 turns a func(A1, A2, A3) (B, error) into a func(A1, A2, A3) Result[B].
+// example:
+//
+//	parse := Lift3(strconv.ParseInt)
+//	fmt.Println(parse("ff", 16, 64).Unwrap())
+//
+// // Output: 255
+func Lift3[A1, A2, A3, B any](f func(A1, A2, A3) (B, error)) func(A1, A2, A3) Result[B] {
+	return func(a1 A1, a2 A2, a3 A3) Result[B] {
+		return From(f(a1, a2, a3))
+	}
+}
+
+// LiftOpt1 turns a func(A) (B, bool) into a func(A) Option[B].
+// The returned option is Some(B) when f reports true, otherwise None.
+// example:
+//
+//	lookup := LiftOpt1(os.LookupEnv)
+//	fmt.Println(lookup("HOME").IsSome())
+//
+// // Output: true
+func LiftOpt1[A, B any](f func(A) (B, bool)) func(A) Option[B] {
+	return func(a A) Option[B] {
+		return fromOk(f(a))
+	}
+}
+
+// LiftOpt2 turns a func(A1, A2) (B, bool) into a func(A1, A2) Option[B].
+func LiftOpt2[A1, A2, B any](f func(A1, A2) (B, bool)) func(A1, A2) Option[B] {
+	return func(a1 A1, a2 A2) Option[B] {
+		return fromOk(f(a1, a2))
+	}
+}
+
+// LiftOpt3 turns a func(A1, A2, A3) (B, bool) into a func(A1, A2, A3) Option[B].
+func LiftOpt3[A1, A2, A3, B any](f func(A1, A2, A3) (B, bool)) func(A1, A2, A3) Option[B] {
+	return func(a1 A1, a2 A2, a3 A3) Option[B] {
+		return fromOk(f(a1, a2, a3))
+	}
+}
+
+// Unlift turns a func(A) Result[B] back into a func(A) (B, error),
+// so Result-based code can satisfy interfaces that expect tuple returns.
+// example:
+//
+//	atoi := Unlift(func(s string) Result[int] {
+//		return From(strconv.Atoi(s))
+//	})
+//	v, err := atoi("1")
+func Unlift[A, B any](f func(A) Result[B]) func(A) (B, error) {
+	return func(a A) (B, error) {
+		return f(a).Get()
+	}
+}
+
+// UnliftOpt turns a func(A) Option[B] back into a func(A) (B, bool).
+func UnliftOpt[A, B any](f func(A) Option[B]) func(A) (B, bool) {
+	return func(a A) (B, bool) {
+		opt := f(a)
+		return opt.Value(), opt.IsSome()
+	}
+}
+
+func fromOk[T any](value T, ok bool) Option[T] {
+	if ok {
+		return Some(value)
+	}
+
+	return None[T]()
+}
@@ -0,0 +1,90 @@
+package goresult
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func Test_Lift1(t *testing.T) {
+	atoi := Lift1(strconv.Atoi)
+
+	assert.Equal(t, Ok(42), atoi("42"))
+	assert.True(t, atoi("hello").IsError())
+}
+
+func Test_Lift2(t *testing.T) {
+	div := Lift2(func(a, b int) (int, error) {
+		if b == 0 {
+			return 0, fmt.Errorf("division by zero")
+		}
+		return a / b, nil
+	})
+
+	assert.Equal(t, Ok(2), div(4, 2))
+	assert.EqualError(t, div(4, 0).Error(), "division by zero")
+}
+
+func Test_Lift3(t *testing.T) {
+	parse := Lift3(strconv.ParseInt)
+
+	assert.Equal(t, Ok(int64(255)), parse("ff", 16, 64))
+	assert.True(t, parse("zz", 16, 64).IsError())
+}
+
+func Test_LiftOpt1(t *testing.T) {
+	m := map[string]int{"a": 1}
+	lookup := LiftOpt1(func(k string) (int, bool) {
+		v, ok := m[k]
+		return v, ok
+	})
+
+	assert.Equal(t, Some(1), lookup("a"))
+	assert.Equal(t, None[int](), lookup("b"))
+}
+
+func Test_LiftOpt2(t *testing.T) {
+	get := LiftOpt2(func(xs []int, i int) (int, bool) {
+		if i < 0 || i >= len(xs) {
+			return 0, false
+		}
+		return xs[i], true
+	})
+
+	assert.Equal(t, Some(2), get([]int{1, 2}, 1))
+	assert.Equal(t, None[int](), get([]int{1, 2}, 2))
+}
+
+func Test_LiftOpt3(t *testing.T) {
+	between := LiftOpt3(func(v, lo, hi int) (int, bool) {
+		return v, v >= lo && v <= hi
+	})
+
+	assert.Equal(t, Some(5), between(5, 0, 10))
+	assert.Equal(t, None[int](), between(11, 0, 10))
+}
+
+func Test_Unlift(t *testing.T) {
+	atoi := Unlift(Lift1(strconv.Atoi))
+
+	v, err := atoi("42")
+	assert.Equal(t, 42, v)
+	assert.Nil(t, err)
+
+	_, err = atoi("hello")
+	assert.Error(t, err)
+}
+
+func Test_UnliftOpt(t *testing.T) {
+	even := UnliftOpt(func(i int) Option[int] {
+		return Some(i).Filter(func(v int) bool { return v%2 == 0 })
+	})
+
+	v, ok := even(2)
+	assert.Equal(t, 2, v)
+	assert.True(t, ok)
+
+	_, ok = even(1)
+	assert.False(t, ok)
+}
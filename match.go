@@ -0,0 +1,145 @@
+package goresult
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// Match calls onOk with the value if r is Ok, otherwise calls onErr with the error, and returns the result.
+// example:
+//
+//	s := Match(From(strconv.Atoi("1")), func(v int) string {
+//		return "number"
+//	}, func(err error) string {
+//		return "not a number"
+//	})
+//	fmt.Println(s)
+//
+// // Output: number
+func Match[T, U any](r Result[T], onOk func(T) U, onErr func(error) U) U {
+	if r.IsOk() {
+		return onOk(r.Value())
+	}
+
+	return onErr(r.Error())
+}
+
+// MatchOpt calls onSome with the value if opt is Some, otherwise calls onNone, and returns the result.
+// example:
+//
+//	s := MatchOpt(Some(1), strconv.Itoa, func() string {
+//		return "none"
+//	})
+//	fmt.Println(s)
+//
+// // Output: 1
+func MatchOpt[T, U any](opt Option[T], onSome func(T) U, onNone func() U) U {
+	if opt.IsSome() {
+		return onSome(opt.Value())
+	}
+
+	return onNone()
+}
+
+// ErrMatcher dispatches a Result on the type of its error, see MatchErr.
+type ErrMatcher[U any] struct {
+	err     error
+	matched bool
+	value   U
+}
+
+// MatchErr starts matching r. If r is Ok, onOk is called with the value and every Case is skipped.
+// Otherwise the first Case whose error type matches the error according to errors.As is called,
+// and Default is called if none matches.
+// example:
+//
+//	status := MatchErr(r, func(u User) int {
+//		return http.StatusOK
+//	}).Case(func(e *NotFoundError) int {
+//		return http.StatusNotFound
+//	}).Case(func(e *ValidationError) int {
+//		return http.StatusBadRequest
+//	}).Default(func(err error) int {
+//		return http.StatusInternalServerError
+//	})
+func MatchErr[T, U any](r Result[T], onOk func(T) U) *ErrMatcher[U] {
+	if r.IsOk() {
+		return &ErrMatcher[U]{matched: true, value: onOk(r.Value())}
+	}
+
+	return &ErrMatcher[U]{err: r.Error()}
+}
+
+// Case calls handler if no earlier case matched and the error matches the parameter type of handler.
+// handler must be a func(E) U, where E is an error type, otherwise Case panics.
+// Prefer the Case function, which checks the handler at compile time.
+func (m *ErrMatcher[U]) Case(handler any) *ErrMatcher[U] {
+	fn := reflect.ValueOf(handler)
+	target := checkCaseHandler[U](fn.Type())
+
+	if m.matched {
+		return m
+	}
+
+	ptr := reflect.New(target)
+	if errors.As(m.err, ptr.Interface()) {
+		m.matched = true
+		reflect.ValueOf(&m.value).Elem().Set(fn.Call([]reflect.Value{ptr.Elem()})[0])
+	}
+
+	return m
+}
+
+// Case calls f if no earlier case of m matched and the error matches E according to errors.As.
+// example:
+//
+//	m := MatchErr(r, func(u User) int {
+//		return http.StatusOK
+//	})
+//	m = Case(m, func(e *NotFoundError) int {
+//		return http.StatusNotFound
+//	})
+//	status := m.Default(func(err error) int {
+//		return http.StatusInternalServerError
+//	})
+func Case[E error, U any](m *ErrMatcher[U], f func(E) U) *ErrMatcher[U] {
+	if m.matched {
+		return m
+	}
+
+	var target E
+	if errors.As(m.err, &target) {
+		m.matched = true
+		m.value = f(target)
+	}
+
+	return m
+}
+
+// Default returns the result of the matched case, or calls f with the error if no case matched.
+func (m *ErrMatcher[U]) Default(f func(error) U) U {
+	if m.matched {
+		return m.value
+	}
+
+	return f(m.err)
+}
+
+// checkCaseHandler returns the error type handled by a handler of type t, panicking if t is not a func(E) U.
+func checkCaseHandler[U any](t reflect.Type) reflect.Type {
+	out := reflect.TypeOf((*U)(nil)).Elem()
+
+	if t.Kind() != reflect.Func || t.NumIn() != 1 || t.NumOut() != 1 || t.Out(0) != out {
+		panic(fmt.Errorf("goresult: case handler must be a func(E) %s, got %s", out, t))
+	}
+
+	in := t.In(0)
+	if in.Kind() != reflect.Interface && !in.Implements(errorType) {
+		panic(fmt.Errorf("goresult: case handler parameter %s does not implement error", in))
+	}
+
+	return in
+}
@@ -0,0 +1,98 @@
+package goresult
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/fs"
+	"os"
+	"strconv"
+	"testing"
+)
+
+func Test_Match(t *testing.T) {
+	onOk := func(v int) string { return strconv.Itoa(v) }
+	onErr := func(err error) string { return err.Error() }
+
+	assert.Equal(t, "1", Match(Ok(1), onOk, onErr))
+	assert.Equal(t, "error", Match(Error[int]("error"), onOk, onErr))
+}
+
+func Test_MatchOpt(t *testing.T) {
+	onNone := func() string { return "none" }
+
+	assert.Equal(t, "1", MatchOpt(Some(1), strconv.Itoa, onNone))
+	assert.Equal(t, "none", MatchOpt(None[int](), strconv.Itoa, onNone))
+}
+
+func matchStatus(r Result[int]) string {
+	return MatchErr(r, func(v int) string {
+		return "ok"
+	}).Case(func(e *fs.PathError) string {
+		return "path " + e.Path
+	}).Case(func(e testNotFoundError) string {
+		return "not found " + e.Key
+	}).Case(func(e *PanicError) string {
+		return "panic"
+	}).Default(func(err error) string {
+		return "default " + err.Error()
+	})
+}
+
+func Test_MatchErr(t *testing.T) {
+	assert.Equal(t, "ok", matchStatus(Ok(1)))
+	assert.Equal(t, "path config.json", matchStatus(Error[int](&fs.PathError{Op: "open", Path: "config.json", Err: os.ErrNotExist})))
+	assert.Equal(t, "not found user", matchStatus(Error[int](fmt.Errorf("load: %w", testNotFoundError{Key: "user"}))))
+	assert.Equal(t, "panic", matchStatus(Try(func() int { panic("boom") })))
+	assert.Equal(t, "default error", matchStatus(Error[int]("error")))
+}
+
+func Test_MatchErr_FirstCaseWins(t *testing.T) {
+	s := MatchErr(Error[int](testNotFoundError{Key: "user"}), func(v int) string {
+		return "ok"
+	}).Case(func(e error) string {
+		return "error"
+	}).Case(func(e testNotFoundError) string {
+		return "not found"
+	}).Default(func(err error) string {
+		return "default"
+	})
+
+	assert.Equal(t, "error", s)
+}
+
+func Test_MatchErr_InvalidHandler(t *testing.T) {
+	m := MatchErr(Error[int]("error"), func(v int) string { return "ok" })
+
+	assert.Panics(t, func() { m.Case("handler") })
+	assert.Panics(t, func() { m.Case(func(e error) int { return 0 }) })
+	assert.Panics(t, func() { m.Case(func(e string) string { return "" }) })
+}
+
+func Test_MatchErr_NilInterface(t *testing.T) {
+	err := MatchErr(Error[int](testNotFoundError{Key: "user"}), func(v int) error {
+		return nil
+	}).Case(func(e testNotFoundError) error {
+		return nil
+	}).Default(func(err error) error {
+		return err
+	})
+
+	assert.Nil(t, err)
+}
+
+func Test_Case(t *testing.T) {
+	status := func(r Result[int]) string {
+		m := MatchErr(r, func(v int) string { return "ok" })
+		m = Case(m, func(e *fs.PathError) string { return "path " + e.Path })
+		m = Case(m, func(e testNotFoundError) string { return "not found " + e.Key })
+		return m.Default(func(err error) string { return "default " + err.Error() })
+	}
+
+	assert.Equal(t, "ok", status(Ok(1)))
+	assert.Equal(t, "path config.json", status(Error[int](&fs.PathError{Op: "open", Path: "config.json", Err: os.ErrNotExist})))
+	assert.Equal(t, "not found user", status(Error[int](fmt.Errorf("load: %w", testNotFoundError{Key: "user"}))))
+	assert.Equal(t, "default error", status(Error[int]("error")))
+
+	m := MatchErr(Error[int](testNotFoundError{Key: "user"}), func(v int) error { return nil })
+	assert.Nil(t, Case(m, func(e testNotFoundError) error { return nil }).Default(func(err error) error { return err }))
+}
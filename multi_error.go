@@ -0,0 +1,154 @@
+package goresult
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// ItemError is the error of the item at Key, an index for slices, a key for maps or a field path for validations.
+// A string key is printed as a path ("user.name: msg"), any other key as an index ("[1]: msg").
+type ItemError struct {
+	Key any
+	Err error
+}
+
+func (e *ItemError) Error() string {
+	if path, ok := e.Key.(string); ok {
+		if path == "" {
+			return e.Err.Error()
+		}
+		return path + ": " + e.Err.Error()
+	}
+
+	return fmt.Sprintf("[%v]: %s", e.Key, e.Err.Error())
+}
+
+func (e *ItemError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON encodes the item error as {"key": key, "error": message}.
+func (e *ItemError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Key   any    `json:"key"`
+		Error string `json:"error"`
+	}{Key: e.Key, Error: e.Err.Error()})
+}
+
+// MultiError is every item error reported while collecting many results or validating a value, in order.
+// errors.Is and errors.As match any of the item errors.
+type MultiError []*ItemError
+
+func (errs MultiError) Error() string {
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+func (errs MultiError) Unwrap() []error {
+	unwrapped := make([]error, len(errs))
+	for i, err := range errs {
+		unwrapped[i] = err
+	}
+
+	return unwrapped
+}
+
+// Format implements fmt.Formatter, %+v prints a summary line followed by one item error per line.
+// example:
+//
+//	fmt.Printf("%+v", errs)
+//
+// // Output:
+// // 2 errors:
+// //   [1]: not found
+// //   [3]: timeout
+func (errs MultiError) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('+') {
+		if len(errs) == 1 {
+			io.WriteString(f, "1 error:")
+		} else {
+			fmt.Fprintf(f, "%d errors:", len(errs))
+		}
+		for _, err := range errs {
+			fmt.Fprintf(f, "\n  %s", err.Error())
+		}
+		return
+	}
+
+	fmt.Fprintf(f, fmt.FormatString(f, verb), errs.Error())
+}
+
+// Keys returns the key of every item error, in order.
+func (errs MultiError) Keys() []any {
+	keys := make([]any, len(errs))
+	for i, err := range errs {
+		keys[i] = err.Key
+	}
+
+	return keys
+}
+
+// CollectMap returns Ok with all values if every result is Ok,
+// otherwise an Error(MultiError) recording the key of every Error result, sorted by compare.
+// example:
+//
+//	r := CollectMap(map[int]Result[int]{10: Error[int]("a"), 2: Error[int]("b")}, cmp.Compare[int])
+//	fmt.Println(r.Error())
+//
+// // Output: [2]: b; [10]: a
+func CollectMap[K comparable, T any](results map[K]Result[T], compare func(a, b K) int) Result[map[K]T] {
+	values, errs := collectMap(results, compare)
+	if len(errs) > 0 {
+		return newError[map[K]T](errs, 1)
+	}
+
+	return Ok(values)
+}
+
+// CollectMapOrdered is like CollectMap, sorting the item errors by the natural order of their keys.
+// example:
+//
+//	r := CollectMapOrdered(map[string]Result[int]{"a": Ok(1), "b": Error[int]("b")})
+//	fmt.Println(r.Error())
+//
+// // Output: b: b
+func CollectMapOrdered[K cmp.Ordered, T any](results map[K]Result[T]) Result[map[K]T] {
+	values, errs := collectMap(results, cmp.Compare[K])
+	if len(errs) > 0 {
+		return newError[map[K]T](errs, 1)
+	}
+
+	return Ok(values)
+}
+
+func collectMap[K comparable, T any](results map[K]Result[T], compare func(a, b K) int) (map[K]T, MultiError) {
+	values := make(map[K]T, len(results))
+	var keys []K
+	for k, r := range results {
+		if r.IsError() {
+			keys = append(keys, k)
+			continue
+		}
+		values[k] = r.Value()
+	}
+
+	if len(keys) == 0 {
+		return values, nil
+	}
+
+	slices.SortFunc(keys, compare)
+	errs := make(MultiError, len(keys))
+	for i, k := range keys {
+		errs[i] = &ItemError{Key: k, Err: results[k].Error()}
+	}
+
+	return values, errs
+}
@@ -0,0 +1,68 @@
+package goresult
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/fs"
+	"testing"
+)
+
+func Test_CollectAll_MultiError(t *testing.T) {
+	r := CollectAll([]Result[int]{Ok(1), Error[int](fs.ErrNotExist), Ok(3), Error[int]("timeout")})
+	var errs MultiError
+	assert.True(t, errors.As(r.Error(), &errs))
+	assert.Equal(t, []any{1, 3}, errs.Keys())
+	assert.EqualError(t, r.Error(), "[1]: file does not exist; [3]: timeout")
+}
+
+func Test_CollectMap(t *testing.T) {
+	assert.Equal(t, Ok(map[string]int{"a": 1}), CollectMapOrdered(map[string]Result[int]{"a": Ok(1)}))
+
+	r := CollectMapOrdered(map[string]Result[int]{"c": Error[int]("c"), "a": Ok(1), "b": Error[int]("b")})
+	var errs MultiError
+	assert.True(t, errors.As(r.Error(), &errs))
+	assert.Equal(t, []any{"b", "c"}, errs.Keys())
+	assert.EqualError(t, r.Error(), "b: b; c: c")
+
+	ints := CollectMapOrdered(map[int]Result[int]{10: Error[int]("a"), 2: Error[int]("b"), 1: Ok(1)})
+	assert.EqualError(t, ints.Error(), "[2]: b; [10]: a")
+
+	reversed := CollectMap(map[int]Result[int]{10: Error[int]("a"), 2: Error[int]("b")}, func(a, b int) int {
+		return b - a
+	})
+	assert.EqualError(t, reversed.Error(), "[10]: a; [2]: b")
+}
+
+func Test_MultiError_Is(t *testing.T) {
+	pathErr := &fs.PathError{Op: "open", Path: "config.json", Err: fs.ErrNotExist}
+	r := CollectAll([]Result[int]{Error[int]("error"), Error[int](pathErr)})
+
+	assert.ErrorIs(t, r.Error(), fs.ErrNotExist)
+
+	var pe *fs.PathError
+	assert.True(t, errors.As(r.Error(), &pe))
+	assert.Equal(t, "config.json", pe.Path)
+
+	var ie *ItemError
+	assert.True(t, errors.As(r.Error(), &ie))
+	assert.Equal(t, 0, ie.Key)
+}
+
+func Test_MultiError_Format(t *testing.T) {
+	errs := MultiError{{Key: 1, Err: errors.New("not found")}, {Key: "b", Err: errors.New("timeout")}}
+
+	assert.Equal(t, "2 errors:\n  [1]: not found\n  b: timeout", fmt.Sprintf("%+v", errs))
+	assert.Equal(t, "1 error:\n  [1]: not found", fmt.Sprintf("%+v", errs[:1]))
+	assert.Equal(t, "[1]: not found; b: timeout", fmt.Sprintf("%v", errs))
+	assert.Equal(t, `"[1]: not found; b: timeout"`, fmt.Sprintf("%q", errs))
+}
+
+func Test_MultiError_MarshalJSON(t *testing.T) {
+	errs := MultiError{{Key: 1, Err: errors.New("not found")}, {Key: "b", Err: errors.New("timeout")}}
+
+	b, err := json.Marshal(errs)
+	assert.Nil(t, err)
+	assert.Equal(t, `[{"key":1,"error":"not found"},{"key":"b","error":"timeout"}]`, string(b))
+}
@@ -0,0 +1,48 @@
+package goresult
+
+// Opt is a concrete, value-typed form of Option[T] that can be used as a struct field.
+// The zero value of Opt is None.
+// example:
+//
+//	type User struct {
+//		Name     string      `json:"name"`
+//		Nickname Opt[string] `json:"nickname"`
+//	}
+type Opt[T any] struct {
+	value T
+	some  bool
+}
+
+// OptOf returns an Opt holding the same value as opt.
+// example:
+//
+//	OptOf(Some(1))
+//	OptOf(None[int]())
+func OptOf[T any](opt Option[T]) Opt[T] {
+	if opt == nil || opt.IsNone() {
+		return Opt[T]{}
+	}
+
+	return Opt[T]{value: opt.Value(), some: true}
+}
+
+// Option returns the Opt as an Option[T].
+// example:
+//
+//	o := OptOf(Some(1))
+//	fmt.Println(o.Option().Unwrap())
+//
+// // Output: 1
+func (o Opt[T]) Option() Option[T] {
+	if o.some {
+		return Some(o.value)
+	}
+
+	return None[T]()
+}
+
+// IsZero returns true if the Opt is None.
+// Since Go 1.24, this lets `json:",omitzero"` omit None fields; older versions ignore the omitzero option.
+func (o Opt[T]) IsZero() bool {
+	return !o.some
+}
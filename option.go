@@ -1,5 +1,10 @@
 package goresult
 
+import "iter"
+
+// Option is either Some(T) or None.
+// Options are immutable and may be shared, so database/sql cannot scan into them, and Value() T keeps them
+// from being a driver.Valuer: scan into an Opt[T] or a sql.Null[T], and pass ToNull(opt) or OptOf(opt) as a query argument.
 type Option[T any] interface {
 	Value() T
 	IsSome() bool
@@ -12,6 +17,7 @@ type Option[T any] interface {
 	OkOr(err interface{}) Result[T]
 	OkOrElse(f func() error) Result[T]
 	Filter(predicate func(value T) bool) Option[T]
+	Iter() iter.Seq[T]
 }
 
 // option is an option type, it is either Some(T) or None.
@@ -30,6 +36,138 @@ func None[T any]() Option[T] {
 	return &option[T]{none: true}
 }
 
+// MapOpt maps an Option[T] to Option[U] by applying f to the contained value, leaving None untouched.
+// example:
+//
+//	opt := MapOpt(Some(1), strconv.Itoa)
+//	fmt.Println(opt.Unwrap())
+//	// Output: 1
+func MapOpt[T, U any](opt Option[T], f func(T) U) Option[U] {
+	if opt.IsSome() {
+		return Some(f(opt.Value()))
+	}
+
+	return None[U]()
+}
+
+// MapOptOr returns the provided default if the option is None, otherwise applies f to the contained value.
+// example:
+//
+//	fmt.Println(MapOptOr(None[int](), "default", strconv.Itoa))
+//	// Output: default
+func MapOptOr[T, U any](opt Option[T], defaults U, f func(T) U) U {
+	if opt.IsSome() {
+		return f(opt.Value())
+	}
+
+	return defaults
+}
+
+// AndThenOpt returns None if the option is None, otherwise calls f with the contained value and returns the result.
+// example:
+//
+//	opt := AndThenOpt(Some("key"), func(k string) Option[int] {
+//		return lookup(k)
+//	})
+func AndThenOpt[T, U any](opt Option[T], f func(T) Option[U]) Option[U] {
+	if opt.IsSome() {
+		return f(opt.Value())
+	}
+
+	return None[U]()
+}
+
+// OrOpt returns opt if it contains a value, otherwise returns optb.
+// example:
+//
+//	fmt.Println(OrOpt(None[int](), Some(2)).Unwrap())
+//	// Output: 2
+func OrOpt[T any](opt Option[T], optb Option[T]) Option[T] {
+	if opt.IsSome() {
+		return opt
+	}
+
+	return optb
+}
+
+// OrElseOpt returns opt if it contains a value, otherwise calls f and returns the result.
+// example:
+//
+//	opt := OrElseOpt(None[int](), func() Option[int] {
+//		return Some(2)
+//	})
+//	fmt.Println(opt.Unwrap())
+//	// Output: 2
+func OrElseOpt[T any](opt Option[T], f func() Option[T]) Option[T] {
+	if opt.IsSome() {
+		return opt
+	}
+
+	return f()
+}
+
+// XorOpt returns Some if exactly one of opt, optb is Some, otherwise returns None.
+// example:
+//
+//	fmt.Println(XorOpt(Some(1), None[int]()).Unwrap())
+//	// Output: 1
+//
+//	fmt.Println(XorOpt(Some(1), Some(2)).IsNone())
+//	// Output: true
+func XorOpt[T any](opt Option[T], optb Option[T]) Option[T] {
+	switch {
+	case opt.IsSome() && optb.IsNone():
+		return opt
+	case opt.IsNone() && optb.IsSome():
+		return optb
+	default:
+		return None[T]()
+	}
+}
+
+// ZipOpt returns Some(Pair{a, b}) if both options are Some, otherwise returns None.
+// example:
+//
+//	opt := ZipOpt(Some(1), Some("hello"))
+//	fmt.Println(opt.Unwrap())
+//	// Output: {1 hello}
+func ZipOpt[T, U any](opt Option[T], other Option[U]) Option[Pair[T, U]] {
+	if opt.IsSome() && other.IsSome() {
+		return Some(Pair[T, U]{First: opt.Value(), Second: other.Value()})
+	}
+
+	return None[Pair[T, U]]()
+}
+
+// UnzipOpt unzips an option containing a Pair into two options.
+// If opt is None, both returned options are None.
+// example:
+//
+//	a, b := UnzipOpt(Some(Pair[int, string]{First: 1, Second: "hello"}))
+//	fmt.Println(a.Unwrap(), b.Unwrap())
+//	// Output: 1 hello
+func UnzipOpt[T, U any](opt Option[Pair[T, U]]) (Option[T], Option[U]) {
+	if opt.IsSome() {
+		pair := opt.Value()
+		return Some(pair.First), Some(pair.Second)
+	}
+
+	return None[T](), None[U]()
+}
+
+// FlattenOpt converts an Option[Option[T]] to an Option[T], removing one level of nesting.
+// example:
+//
+//	fmt.Println(FlattenOpt(Some(Some(1))).Unwrap())
+//	// Output: 1
+func FlattenOpt[T any](opt Option[Option[T]]) Option[T] {
+	if opt.IsSome() {
+		return opt.Value()
+	}
+
+	return None[T]()
+}
+
 // Value return value
 // example:
 //
@@ -164,7 +302,7 @@ func (opt *option[T]) OkOr(err interface{}) Result[T] {
 		return Ok[T](opt.value)
 	}
 
-	return Error[T](err)
+	return newError[T](err, 1)
 }
 
 // OkOrElse returns an Ok(T) containing the inner T of a Some(T).
@@ -189,7 +327,7 @@ func (opt *option[T]) OkOrElse(f func() error) Result[T] {
 		return Ok[T](opt.value)
 	}
 
-	return Error[T](f())
+	return newError[T](f(), 1)
 }
 
 // Filter returns None if the self value equals None, otherwise calls predicate with the wrapped value and returns:
@@ -0,0 +1,55 @@
+package goresult
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+var jsonNull = []byte("null")
+
+// MarshalJSON encodes Some(v) as v and None as null.
+// Options are immutable, so they cannot be decoded into; use an Opt[T] field to decode JSON.
+// example:
+//
+//	b, _ := json.Marshal(Some(1))
+//	fmt.Println(string(b))
+//	// Output: 1
+func (opt *option[T]) MarshalJSON() ([]byte, error) {
+	if opt.IsNone() {
+		return jsonNull, nil
+	}
+
+	return json.Marshal(opt.value)
+}
+
+// UnmarshalJSON always fails: options are immutable and may be shared, so they are never decoded in place.
+func (opt *option[T]) UnmarshalJSON([]byte) error {
+	return errors.New("goresult: cannot decode into an Option[T], use an Opt[T] field")
+}
+
+// MarshalJSON encodes Some(v) as v and None as null.
+func (o Opt[T]) MarshalJSON() ([]byte, error) {
+	if !o.some {
+		return jsonNull, nil
+	}
+
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes null as None and any other value as Some(v).
+// Fields missing from the input are left untouched, so they stay None.
+func (o *Opt[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		*o = Opt[T]{}
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	*o = Opt[T]{value: value, some: true}
+	return nil
+}
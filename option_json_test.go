@@ -0,0 +1,68 @@
+package goresult
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type testUser struct {
+	Name     string      `json:"name"`
+	Nickname Opt[string] `json:"nickname"`
+	Age      Opt[int]    `json:"age"`
+}
+
+func Test_Option_MarshalJSON(t *testing.T) {
+	b, err := json.Marshal(Some(1))
+	assert.Nil(t, err)
+	assert.Equal(t, "1", string(b))
+
+	b, err = json.Marshal(None[int]())
+	assert.Nil(t, err)
+	assert.Equal(t, "null", string(b))
+
+	b, err = json.Marshal(map[string]Option[string]{"v": Some("hello")})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"v":"hello"}`, string(b))
+}
+
+func Test_Option_UnmarshalJSON(t *testing.T) {
+	var payload struct {
+		V Option[int] `json:"v"`
+	}
+	assert.Error(t, json.Unmarshal([]byte(`{"v":1}`), &payload))
+
+	opt := Some(1)
+	payload.V = opt
+	assert.Error(t, json.Unmarshal([]byte(`{"v":2}`), &payload))
+	assert.Equal(t, 1, opt.Unwrap())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"v":{}}`), &payload))
+}
+
+func Test_Opt_MarshalJSON(t *testing.T) {
+	b, err := json.Marshal(testUser{Name: "a", Nickname: OptOf(Some("b"))})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"name":"a","nickname":"b","age":null}`, string(b))
+}
+
+func Test_Opt_UnmarshalJSON(t *testing.T) {
+	var u testUser
+	assert.Nil(t, json.Unmarshal([]byte(`{"name":"a","nickname":null,"age":18}`), &u))
+	assert.Equal(t, None[string](), u.Nickname.Option())
+	assert.Equal(t, Some(18), u.Age.Option())
+
+	u = testUser{}
+	assert.Nil(t, json.Unmarshal([]byte(`{"name":"a"}`), &u))
+	assert.Equal(t, None[string](), u.Nickname.Option())
+	assert.Equal(t, None[int](), u.Age.Option())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"age":"18"}`), &u))
+}
+
+func Test_OptOf(t *testing.T) {
+	assert.Equal(t, Some(1), OptOf(Some(1)).Option())
+	assert.Equal(t, None[int](), OptOf(None[int]()).Option())
+	assert.True(t, OptOf(None[int]()).IsZero())
+	assert.False(t, OptOf(Some(0)).IsZero())
+}
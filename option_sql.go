@@ -0,0 +1,57 @@
+package goresult
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+// FromNull returns Some(n.V) if n is valid, otherwise None.
+// example:
+//
+//	FromNull(sql.Null[string]{V: "hello", Valid: true})
+func FromNull[T any](n sql.Null[T]) Option[T] {
+	return fromOk(n.V, n.Valid)
+}
+
+// ToNull returns opt as a sql.Null[T], with None mapped to an invalid value.
+// Use it to pass an Option as a query argument, since an Option is not a driver.Valuer.
+// example:
+//
+//	db.Exec("UPDATE users SET nickname = ?", ToNull(Some("hello")))
+func ToNull[T any](opt Option[T]) sql.Null[T] {
+	return sql.Null[T]{V: opt.Value(), Valid: opt.IsSome()}
+}
+
+// Scan implements sql.Scanner. NULL is scanned as None, any other value as Some(T).
+// Use an Opt as the scan destination of an Option column, since an Option is immutable and cannot be scanned into.
+// example:
+//
+//	var nickname Opt[string]
+//	row.Scan(&nickname)
+func (o *Opt[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+
+	*o = Opt[T]{value: n.V, some: n.Valid}
+	return nil
+}
+
+// Value implements driver.Valuer, so an Opt can be used as a query argument.
+// None is passed as NULL, Some(T) is converted through driver.DefaultParameterConverter.
+// example:
+//
+//	db.Exec("UPDATE users SET nickname = ?", OptOf(None[string]()))
+func (o Opt[T]) Value() (driver.Value, error) {
+	if !o.some {
+		return nil, nil
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(o.value)
+}
+
+// Null returns the Opt as a sql.Null[T].
+func (o Opt[T]) Null() sql.Null[T] {
+	return sql.Null[T]{V: o.value, Valid: o.some}
+}
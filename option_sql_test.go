@@ -0,0 +1,134 @@
+package goresult
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+// testDriver is a tiny in-memory database/sql driver.
+// Queries return testRows, and Exec records its arguments in testArgs.
+type testDriver struct{}
+
+var (
+	testRows = [][]driver.Value{{"a", int64(18)}, {nil, nil}}
+	testArgs []driver.Value
+)
+
+func init() {
+	sql.Register("goresult-test", testDriver{})
+}
+
+func (testDriver) Open(string) (driver.Conn, error) { return testConn{}, nil }
+
+type testConn struct{}
+
+func (testConn) Prepare(string) (driver.Stmt, error) { return testStmt{}, nil }
+func (testConn) Close() error                        { return nil }
+func (testConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+type testStmt struct{}
+
+func (testStmt) Close() error  { return nil }
+func (testStmt) NumInput() int { return -1 }
+
+func (testStmt) Exec(args []driver.Value) (driver.Result, error) {
+	testArgs = args
+	return driver.RowsAffected(1), nil
+}
+
+func (testStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &testRowsIter{}, nil
+}
+
+type testRowsIter struct {
+	i int
+}
+
+func (r *testRowsIter) Columns() []string { return []string{"name", "age"} }
+func (r *testRowsIter) Close() error      { return nil }
+
+func (r *testRowsIter) Next(dest []driver.Value) error {
+	if r.i >= len(testRows) {
+		return io.EOF
+	}
+	copy(dest, testRows[r.i])
+	r.i++
+	return nil
+}
+
+func openTestDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("goresult-test", "")
+	assert.Nil(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+func Test_Opt_Scan(t *testing.T) {
+	rows, err := openTestDB(t).Query("SELECT name, age FROM users")
+	assert.Nil(t, err)
+	defer rows.Close()
+
+	var names []Opt[string]
+	var ages []Opt[int]
+	for rows.Next() {
+		var name Opt[string]
+		var age Opt[int]
+		assert.Nil(t, rows.Scan(&name, &age))
+		names = append(names, name)
+		ages = append(ages, age)
+	}
+
+	assert.Equal(t, []Opt[string]{OptOf(Some("a")), {}}, names)
+	assert.Equal(t, []Opt[int]{OptOf(Some(18)), {}}, ages)
+}
+
+func Test_Option_Scan(t *testing.T) {
+	var name Opt[string]
+	var age sql.Null[int]
+	assert.Nil(t, openTestDB(t).QueryRow("SELECT name, age FROM users").Scan(&name, &age))
+	assert.Equal(t, Some("a"), name.Option())
+	assert.Equal(t, Some(18), FromNull(age))
+
+	opt := None[int]()
+	_, ok := opt.(sql.Scanner)
+	assert.False(t, ok)
+	err := openTestDB(t).QueryRow("SELECT name, age FROM users").Scan(&name, opt)
+	assert.ErrorContains(t, err, "unsupported Scan")
+	assert.Equal(t, None[int](), opt)
+}
+
+func Test_Opt_Value(t *testing.T) {
+	_, err := openTestDB(t).Exec("INSERT INTO users VALUES (?, ?)", OptOf(Some("a")), OptOf(None[int]()))
+	assert.Nil(t, err)
+	assert.Equal(t, []driver.Value{"a", nil}, testArgs)
+
+	v, err := OptOf(Some(int32(1))).Value()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), v)
+}
+
+func Test_Option_Exec(t *testing.T) {
+	db := openTestDB(t)
+
+	_, err := db.Exec("INSERT INTO users VALUES (?, ?)", ToNull(Some("a")), ToNull(None[int]()))
+	assert.Nil(t, err)
+	assert.Equal(t, []driver.Value{"a", nil}, testArgs)
+
+	_, err = db.Exec("INSERT INTO users VALUES (?)", Some("a"))
+	assert.Error(t, err)
+}
+
+func Test_Option_Null(t *testing.T) {
+	assert.Equal(t, Some("a"), FromNull(sql.Null[string]{V: "a", Valid: true}))
+	assert.Equal(t, None[string](), FromNull(sql.Null[string]{}))
+
+	assert.Equal(t, sql.Null[string]{V: "a", Valid: true}, ToNull(Some("a")))
+	assert.Equal(t, sql.Null[string]{}, ToNull(None[string]()))
+
+	assert.Equal(t, sql.Null[int]{V: 1, Valid: true}, OptOf(Some(1)).Null())
+	assert.Equal(t, sql.Null[int]{}, Opt[int]{}.Null())
+}
@@ -3,6 +3,7 @@ package goresult
 import (
 	"fmt"
 	"github.com/stretchr/testify/assert"
+	"strconv"
 	"testing"
 )
 
@@ -141,3 +142,68 @@ func Test_Option_Filter_None(t *testing.T) {
 
 	assert.Equal(t, opt.Filter(func(i int) bool { return i == 1 }), opt)
 }
+
+func Test_Option_MapOpt(t *testing.T) {
+	assert.Equal(t, Some("1"), MapOpt(Some(1), strconv.Itoa))
+	assert.Equal(t, None[string](), MapOpt(None[int](), strconv.Itoa))
+}
+
+func Test_Option_MapOptOr(t *testing.T) {
+	assert.Equal(t, "1", MapOptOr(Some(1), "default", strconv.Itoa))
+	assert.Equal(t, "default", MapOptOr(None[int](), "default", strconv.Itoa))
+}
+
+func Test_Option_AndThenOpt(t *testing.T) {
+	half := func(i int) Option[int] {
+		if i%2 != 0 {
+			return None[int]()
+		}
+		return Some(i / 2)
+	}
+
+	assert.Equal(t, Some(2), AndThenOpt(Some(4), half))
+	assert.Equal(t, None[int](), AndThenOpt(Some(3), half))
+	assert.Equal(t, None[int](), AndThenOpt(None[int](), half))
+}
+
+func Test_Option_OrOpt(t *testing.T) {
+	assert.Equal(t, Some(1), OrOpt(Some(1), Some(2)))
+	assert.Equal(t, Some(2), OrOpt(None[int](), Some(2)))
+	assert.Equal(t, None[int](), OrOpt(None[int](), None[int]()))
+}
+
+func Test_Option_OrElseOpt(t *testing.T) {
+	f := func() Option[int] { return Some(2) }
+
+	assert.Equal(t, Some(1), OrElseOpt(Some(1), f))
+	assert.Equal(t, Some(2), OrElseOpt(None[int](), f))
+}
+
+func Test_Option_XorOpt(t *testing.T) {
+	assert.Equal(t, Some(1), XorOpt(Some(1), None[int]()))
+	assert.Equal(t, Some(2), XorOpt(None[int](), Some(2)))
+	assert.Equal(t, None[int](), XorOpt(Some(1), Some(2)))
+	assert.Equal(t, None[int](), XorOpt(None[int](), None[int]()))
+}
+
+func Test_Option_ZipOpt(t *testing.T) {
+	assert.Equal(t, Some(Pair[int, string]{First: 1, Second: "hello"}), ZipOpt(Some(1), Some("hello")))
+	assert.Equal(t, None[Pair[int, string]](), ZipOpt(Some(1), None[string]()))
+	assert.Equal(t, None[Pair[int, string]](), ZipOpt(None[int](), Some("hello")))
+}
+
+func Test_Option_UnzipOpt(t *testing.T) {
+	a, b := UnzipOpt(Some(Pair[int, string]{First: 1, Second: "hello"}))
+	assert.Equal(t, Some(1), a)
+	assert.Equal(t, Some("hello"), b)
+
+	a, b = UnzipOpt(None[Pair[int, string]]())
+	assert.Equal(t, None[int](), a)
+	assert.Equal(t, None[string](), b)
+}
+
+func Test_Option_FlattenOpt(t *testing.T) {
+	assert.Equal(t, Some(1), FlattenOpt(Some(Some(1))))
+	assert.Equal(t, None[int](), FlattenOpt(Some(None[int]())))
+	assert.Equal(t, None[int](), FlattenOpt(None[Option[int]]()))
+}
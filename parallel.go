@@ -0,0 +1,90 @@
+package goresult
+
+import (
+	"context"
+	"sync"
+)
+
+// ParallelMap calls f with each element of xs in its own goroutine, running at most limit calls at once,
+// and returns the results in the order of xs. A limit of zero or less means no limit.
+// Elements not started before ctx is done get Error(ctx.Err()), and panics in f are returned as Error(*PanicError).
+// example:
+//
+//	results := ParallelMap(ctx, ids, 8, func(ctx context.Context, id int) Result[User] {
+//		return fetchUser(ctx, id)
+//	})
+func ParallelMap[A, B any](ctx context.Context, xs []A, limit int, f func(context.Context, A) Result[B]) []Result[B] {
+	results := make([]Result[B], len(xs))
+
+	var sem chan struct{}
+	if limit > 0 {
+		sem = make(chan struct{}, limit)
+	}
+
+	var wg sync.WaitGroup
+	for i, x := range xs {
+		if sem != nil {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				results[i] = newError[B](ctx.Err(), 1)
+				continue
+			}
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if sem != nil {
+				defer func() { <-sem }()
+			}
+
+			if err := ctx.Err(); err != nil {
+				results[i] = newError[B](err, 1)
+				return
+			}
+
+			results[i] = goResult(func() Result[B] {
+				return f(ctx, x)
+			})
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+// ParallelTraverse is like ParallelMap, but fails fast: the first Error cancels the context passed to the
+// remaining calls and is returned. Otherwise it returns Ok with the values in the order of xs.
+// example:
+//
+//	users := ParallelTraverse(ctx, ids, 8, func(ctx context.Context, id int) Result[User] {
+//		return fetchUser(ctx, id)
+//	})
+func ParallelTraverse[A, B any](ctx context.Context, xs []A, limit int, f func(context.Context, A) Result[B]) Result[[]B] {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var once sync.Once
+	var first Result[B]
+
+	results := ParallelMap(ctx, xs, limit, func(ctx context.Context, x A) Result[B] {
+		r := goResult(func() Result[B] {
+			return f(ctx, x)
+		})
+		if r.IsError() {
+			once.Do(func() {
+				first = r
+				cancel()
+			})
+		}
+
+		return r
+	})
+
+	if first != nil {
+		return errorOf[[]B](first)
+	}
+
+	return Collect(results)
+}
@@ -0,0 +1,108 @@
+package goresult
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_ParallelMap(t *testing.T) {
+	xs := []int{5, 4, 3, 2, 1}
+	results := ParallelMap(context.Background(), xs, 2, func(_ context.Context, x int) Result[int] {
+		time.Sleep(time.Duration(x) * time.Millisecond)
+		if x == 3 {
+			return Error[int]("three")
+		}
+		return Ok(x * 10)
+	})
+
+	assert.Len(t, results, 5)
+	assert.Equal(t, Ok(50), results[0])
+	assert.Equal(t, Ok(40), results[1])
+	assert.EqualError(t, results[2].Error(), "three")
+	assert.Equal(t, Ok(20), results[3])
+	assert.Equal(t, Ok(10), results[4])
+}
+
+func Test_ParallelMap_Limit(t *testing.T) {
+	var running, peak atomic.Int32
+	xs := make([]int, 20)
+
+	ParallelMap(context.Background(), xs, 3, func(_ context.Context, x int) Result[int] {
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		running.Add(-1)
+		return Ok(x)
+	})
+
+	assert.LessOrEqual(t, peak.Load(), int32(3))
+}
+
+func Test_ParallelMap_Panic(t *testing.T) {
+	results := ParallelMap(context.Background(), []int{1, 2}, 0, func(_ context.Context, x int) Result[int] {
+		if x == 2 {
+			panic("boom")
+		}
+		return Ok(x)
+	})
+
+	assert.Equal(t, Ok(1), results[0])
+	assert.EqualError(t, results[1].Error(), "panic: boom")
+}
+
+func Test_ParallelMap_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var calls atomic.Int32
+	results := ParallelMap(ctx, []int{1, 2, 3}, 1, func(_ context.Context, x int) Result[int] {
+		calls.Add(1)
+		return Ok(x)
+	})
+
+	assert.Equal(t, int32(0), calls.Load())
+	for _, r := range results {
+		assert.ErrorIs(t, r.Error(), context.Canceled)
+	}
+}
+
+func Test_ParallelTraverse(t *testing.T) {
+	r := ParallelTraverse(context.Background(), []int{1, 2, 3}, 2, func(_ context.Context, x int) Result[string] {
+		return Ok(fmt.Sprint(x))
+	})
+
+	assert.Equal(t, Ok([]string{"1", "2", "3"}), r)
+}
+
+func Test_ParallelTraverse_FailFast(t *testing.T) {
+	err := fmt.Errorf("error")
+	var completed atomic.Int32
+	start := time.Now()
+
+	r := ParallelTraverse(context.Background(), []int{0, 1, 2, 3}, 0, func(ctx context.Context, x int) Result[int] {
+		if x == 0 {
+			return Error[int](err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return Error[int](ctx.Err())
+		case <-time.After(time.Second):
+			completed.Add(1)
+			return Ok(x)
+		}
+	})
+
+	assert.Equal(t, err, r.Error())
+	assert.Equal(t, int32(0), completed.Load())
+	assert.Less(t, time.Since(start), time.Second)
+}
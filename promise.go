@@ -0,0 +1,94 @@
+package goresult
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNoTasks is returned by Any and Race when called without tasks.
+var ErrNoTasks = errors.New("goresult: no tasks")
+
+// Task is a Result-producing function run concurrently by All, AllSettled, Any and Race.
+type Task[T any] func(context.Context) Result[T]
+
+// All runs every task concurrently and returns Ok with their values in order.
+// The first Error cancels the remaining tasks and is returned.
+// example:
+//
+//	r := All(ctx, fetchUser, fetchOrders)
+func All[T any](ctx context.Context, tasks ...Task[T]) Result[[]T] {
+	return ParallelTraverse(ctx, tasks, 0, runTask[T])
+}
+
+// AllSettled runs every task concurrently, waits for all of them and returns their results in order.
+// example:
+//
+//	results := AllSettled(ctx, fetchUser, fetchOrders)
+func AllSettled[T any](ctx context.Context, tasks ...Task[T]) []Result[T] {
+	return ParallelMap(ctx, tasks, 0, runTask[T])
+}
+
+// Any runs every task concurrently and returns the first Ok, canceling the remaining tasks.
+// If every task fails, it returns an Error(MultiError) recording the index of every task with its error, in the order of tasks.
+// example:
+//
+//	r := Any(ctx, fetchFromPrimary, fetchFromReplica)
+func Any[T any](ctx context.Context, tasks ...Task[T]) Result[T] {
+	if len(tasks) == 0 {
+		return newError[T](ErrNoTasks, 1)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	settled := settle(ctx, tasks)
+	errs := make(MultiError, len(tasks))
+	for range tasks {
+		s := <-settled
+		if s.result.IsOk() {
+			return s.result
+		}
+		errs[s.index] = &ItemError{Key: s.index, Err: s.result.Error()}
+	}
+
+	return newError[T](errs, 1)
+}
+
+// Race runs every task concurrently and returns the first settled result, Ok or Error, canceling the remaining tasks.
+// example:
+//
+//	r := Race(ctx, fetchUser, timeout(time.Second))
+func Race[T any](ctx context.Context, tasks ...Task[T]) Result[T] {
+	if len(tasks) == 0 {
+		return newError[T](ErrNoTasks, 1)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	return (<-settle(ctx, tasks)).result
+}
+
+type settledTask[T any] struct {
+	index  int
+	result Result[T]
+}
+
+// settle runs every task in its own goroutine and sends each result as it settles.
+// The channel is buffered so tasks finishing after the caller stopped receiving do not leak.
+func settle[T any](ctx context.Context, tasks []Task[T]) <-chan settledTask[T] {
+	settled := make(chan settledTask[T], len(tasks))
+	for i, task := range tasks {
+		go func() {
+			settled <- settledTask[T]{index: i, result: goResult(func() Result[T] {
+				return task(ctx)
+			})}
+		}()
+	}
+
+	return settled
+}
+
+func runTask[T any](ctx context.Context, task Task[T]) Result[T] {
+	return task(ctx)
+}
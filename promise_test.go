@@ -0,0 +1,74 @@
+package goresult
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func testTask(v int, delay time.Duration, err error) Task[int] {
+	return func(ctx context.Context) Result[int] {
+		select {
+		case <-ctx.Done():
+			return Error[int](ctx.Err())
+		case <-time.After(delay):
+		}
+
+		if err != nil {
+			return Error[int](err)
+		}
+		return Ok(v)
+	}
+}
+
+func Test_All(t *testing.T) {
+	ctx := context.Background()
+
+	assert.Equal(t, Ok([]int{1, 2}), All(ctx, testTask(1, 2*time.Millisecond, nil), testTask(2, 0, nil)))
+	assert.Equal(t, Ok([]int{}), All[int](ctx))
+
+	err := fmt.Errorf("error")
+	start := time.Now()
+	assert.Equal(t, err, All(ctx, testTask(1, time.Second, nil), testTask(2, 0, err)).Error())
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func Test_AllSettled(t *testing.T) {
+	err := fmt.Errorf("error")
+	results := AllSettled(context.Background(), testTask(1, 2*time.Millisecond, nil), testTask(2, 0, err))
+
+	assert.Equal(t, []Result[int]{Ok(1), Error[int](err)}, results)
+}
+
+func Test_Any(t *testing.T) {
+	ctx := context.Background()
+	errA, errB := fmt.Errorf("a"), fmt.Errorf("b")
+
+	assert.Equal(t, Ok(2), Any(ctx, testTask(1, 0, errA), testTask(2, 2*time.Millisecond, nil), testTask(3, time.Second, nil)))
+
+	r := Any(ctx, testTask(1, 2*time.Millisecond, errA), testTask(2, 0, errB))
+	assert.EqualError(t, r.Error(), "[0]: a; [1]: b")
+	assert.True(t, errors.Is(r.Error(), errA))
+	assert.True(t, errors.Is(r.Error(), errB))
+
+	assert.ErrorIs(t, Any[int](ctx).Error(), ErrNoTasks)
+}
+
+func Test_Race(t *testing.T) {
+	ctx := context.Background()
+	err := fmt.Errorf("error")
+
+	assert.Equal(t, Ok(2), Race(ctx, testTask(1, time.Second, nil), testTask(2, 0, nil)))
+	assert.Equal(t, err, Race(ctx, testTask(1, time.Second, nil), testTask(2, 0, err)).Error())
+	assert.ErrorIs(t, Race[int](ctx).Error(), ErrNoTasks)
+}
+
+func Test_Race_Panic(t *testing.T) {
+	r := Race(context.Background(), func(context.Context) Result[int] { panic("boom") })
+
+	var pe *PanicError
+	assert.True(t, errors.As(r.Error(), &pe))
+}
@@ -0,0 +1,34 @@
+package goresult
+
+// Res is a concrete, value-typed form of Result[T] that can be used as a struct field.
+// The zero value of Res is Ok with the zero value of T.
+// example:
+//
+//	type Response struct {
+//		User Res[User] `json:"user"`
+//	}
+type Res[T any] struct {
+	value  T
+	error  error
+	frames []uintptr
+}
+
+// ResOf returns a Res holding the same value or error as r, keeping the frames captured by r.
+// example:
+//
+//	ResOf(Ok(1))
+//	ResOf(Error[int]("something went wrong"))
+func ResOf[T any](r Result[T]) Res[T] {
+	return Res[T]{value: r.Value(), error: r.Error(), frames: framesOf(r)}
+}
+
+// Result returns the Res as a Result[T].
+// example:
+//
+//	r := ResOf(Ok(1))
+//	fmt.Println(r.Result().Unwrap())
+//
+// // Output: 1
+func (r Res[T]) Result() Result[T] {
+	return &result[T]{value: r.value, error: r.error, frames: r.frames}
+}
@@ -1,8 +1,11 @@
 package goresult
 
 import (
+	"errors"
 	"fmt"
+	"iter"
 	"reflect"
+	"runtime"
 )
 
 type Result[T any] interface {
@@ -11,6 +14,9 @@ type Result[T any] interface {
 	Error() error
 	IsOk() bool
 	IsError() bool
+	IsOkAnd(predicate func(T) bool) bool
+	IsErrorAnd(predicate func(error) bool) bool
+	IsErrorIs(target error) bool
 	Except(msg string) T
 	ExceptError(msg string) error
 	Inspect(f func(T)) Result[T]
@@ -21,12 +27,16 @@ type Result[T any] interface {
 	UnwrapOrDefault() T
 	UnwrapOrElse(f func() T) T
 	Option() Option[T]
+	Get() (T, error)
+	Frames() []runtime.Frame
+	Iter() iter.Seq[T]
 }
 
 // result is a generic type that represents either success (Ok) or failure (Error).
 type result[T any] struct {
-	value T
-	error error
+	value  T
+	error  error
+	frames []uintptr
 }
 
 // Ok returns a result that is Ok.
@@ -51,9 +61,37 @@ func Ok[T any](value T) Result[T] {
 //	error[string]("something went wrong")
 //	error[any](fmt.Errorf("something went wrong"))
 func Error[T any](err interface{}) Result[T] {
-	return &result[T]{
-		error: covertError(err),
+	return newError[T](err, 1)
+}
+
+// From returns a result built from a (T, error) pair.
+// - If err is nil, the returned result will be Ok(value).
+// - Otherwise, the returned result will be Error(err).
+// example:
+//
+//	From(strconv.Atoi("1"))
+//	From(os.ReadFile("config.json"))
+func From[T any](value T, err error) Result[T] {
+	if err != nil {
+		return newError[T](err, 1)
+	}
+
+	return Ok(value)
+}
+
+// FromFunc calls f and returns a result built from its (T, error) return values.
+// example:
+//
+//	FromFunc(func() (int, error) {
+//		return strconv.Atoi("1")
+//	})
+func FromFunc[T any](f func() (T, error)) Result[T] {
+	value, err := f()
+	if err != nil {
+		return newError[T](err, 1)
 	}
+
+	return Ok(value)
 }
 
 // Value return value
@@ -111,6 +149,41 @@ func (r *result[T]) IsError() bool {
 	return r.error != nil
 }
 
+// IsOkAnd returns true if the result is Ok and the value matches predicate.
+// example:
+//
+//	result := Ok(1)
+//	fmt.Println(result.IsOkAnd(func(v int) bool {
+//		return v > 0
+//	}))
+//
+// // Output: true
+func (r *result[T]) IsOkAnd(predicate func(T) bool) bool {
+	return r.IsOk() && predicate(r.value)
+}
+
+// IsErrorAnd returns true if the result is Error and the error matches predicate.
+// example:
+//
+//	result := error[int](os.ErrNotExist)
+//	fmt.Println(result.IsErrorAnd(os.IsNotExist))
+//
+// // Output: true
+func (r *result[T]) IsErrorAnd(predicate func(error) bool) bool {
+	return r.IsError() && predicate(r.error)
+}
+
+// IsErrorIs returns true if the result is Error and errors.Is(error, target) reports true.
+// example:
+//
+//	result := error[int](fmt.Errorf("load: %w", os.ErrNotExist))
+//	fmt.Println(result.IsErrorIs(os.ErrNotExist))
+//
+// // Output: true
+func (r *result[T]) IsErrorIs(target error) bool {
+	return r.IsError() && errors.Is(r.error, target)
+}
+
 // Except returns the value if the result is Ok, otherwise it panics with the given message.
 // example:
 //
@@ -284,11 +357,188 @@ func (r *result[T]) Option() Option[T] {
 	return None[T]()
 }
 
+// Get returns the value and the error as a (T, error) pair, for passing the result back to idiomatic Go APIs.
+// example:
+//
+//	result := Ok(1)
+//	v, err := result.Get()
+//	fmt.Println(v, err)
+//
+// // Output: 1 <nil>
+func (r *result[T]) Get() (T, error) {
+	return r.value, r.error
+}
+
+// Frames returns the caller frames captured when the Error was created, see SetFrameCapture and ErrorWithFrames.
+// It returns nil if the result is Ok or no frames were captured.
+// example:
+//
+//	result := ErrorWithFrames[int]("something went wrong")
+//	fmt.Println(result.Frames()[0].Function)
+//
+// // Output: main.main
+func (r *result[T]) Frames() []runtime.Frame {
+	return resolveFrames(r.frames)
+}
+
+// ErrorAs returns Some(e) if the error of r matches E according to errors.As, otherwise None.
+// example:
+//
+//	result := Error[int](&fs.PathError{Op: "open", Path: "config.json", Err: fs.ErrNotExist})
+//	fmt.Println(ErrorAs[*fs.PathError](result).Unwrap().Path)
+//
+// // Output: config.json
+func ErrorAs[E error, T any](r Result[T]) Option[E] {
+	var target E
+	if r.IsError() && errors.As(r.Error(), &target) {
+		return Some(target)
+	}
+
+	return None[E]()
+}
+
+// Map maps a Result[T] to Result[U] by applying f to the contained value, leaving an Error untouched.
+// example:
+//
+//	r := Map(Ok(1), func(v int) string {
+//		return strconv.Itoa(v)
+//	})
+//	fmt.Println(r.Unwrap())
+//
+// // Output: 1
+func Map[T, U any](r Result[T], f func(T) U) Result[U] {
+	if r.IsOk() {
+		return Ok(f(r.Value()))
+	}
+
+	return errorOf[U](r)
+}
+
+// MapErr maps a Result[T] by applying f to the contained error, leaving an Ok untouched.
+// example:
+//
+//	r := MapErr(Error[int]("error"), func(err error) error {
+//		return fmt.Errorf("wrapped: %w", err)
+//	})
+//	fmt.Println(r.Error())
+//
+// // Output: wrapped: error
+func MapErr[T any](r Result[T], f func(error) error) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+
+	return &result[T]{error: covertError(f(r.Error())), frames: framesOf(r)}
+}
+
+// MapOr returns the provided default if the result is Error, otherwise applies f to the contained value.
+// example:
+//
+//	fmt.Println(MapOr(Ok(1), "default", strconv.Itoa))
+//
+// // Output: 1
+//
+//	fmt.Println(MapOr(Error[int]("error"), "default", strconv.Itoa))
+//
+// // Output: default
+func MapOr[T, U any](r Result[T], defaults U, f func(T) U) U {
+	if r.IsOk() {
+		return f(r.Value())
+	}
+
+	return defaults
+}
+
+// MapOrElse applies fallback to the contained error if the result is Error, otherwise applies f to the contained value.
+// example:
+//
+//	fmt.Println(MapOrElse(Error[int]("error"), func(err error) string {
+//		return err.Error()
+//	}, strconv.Itoa))
+//
+// // Output: error
+func MapOrElse[T, U any](r Result[T], fallback func(error) U, f func(T) U) U {
+	if r.IsOk() {
+		return f(r.Value())
+	}
+
+	return fallback(r.Error())
+}
+
+// AndThen calls f with the contained value if the result is Ok, otherwise returns the Error.
+// example:
+//
+//	r := AndThen(Ok("1"), func(v string) Result[int] {
+//		i, err := strconv.Atoi(v)
+//		if err != nil {
+//			return Error[int](err)
+//		}
+//		return Ok(i)
+//	})
+//	fmt.Println(r.Unwrap())
+//
+// // Output: 1
+func AndThen[T, U any](r Result[T], f func(T) Result[U]) Result[U] {
+	if r.IsOk() {
+		return f(r.Value())
+	}
+
+	return errorOf[U](r)
+}
+
+// And returns res if the result is Ok, otherwise returns the Error of r.
+// example:
+//
+//	fmt.Println(And(Ok(1), Ok("hello")).Unwrap())
+//
+// // Output: hello
+func And[T, U any](r Result[T], res Result[U]) Result[U] {
+	if r.IsOk() {
+		return res
+	}
+
+	return errorOf[U](r)
+}
+
+// Or returns res if the result is Error, otherwise returns r.
+// example:
+//
+//	fmt.Println(Or(Error[int]("error"), Ok(2)).Unwrap())
+//
+// // Output: 2
+func Or[T any](r Result[T], res Result[T]) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+
+	return res
+}
+
+// OrElse calls f with the contained error if the result is Error, otherwise returns r.
+// example:
+//
+//	r := OrElse(Error[int]("error"), func(err error) Result[int] {
+//		return Ok(2)
+//	})
+//	fmt.Println(r.Unwrap())
+//
+// // Output: 2
+func OrElse[T any](r Result[T], f func(error) Result[T]) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+
+	return f(r.Error())
+}
+
 func unwrapErrorFailed[E error](msg string, err E) {
 	panic(fmt.Errorf("%s: %s", msg, err.Error()))
 }
 
 func unwrapValueFailed[T any](msg string, value T) {
-	types := reflect.TypeOf(value).String()
+	types := "<nil>"
+	if t := reflect.TypeOf(value); t != nil {
+		types = t.String()
+	}
 	panic(fmt.Errorf("%s: %s", msg, types))
 }
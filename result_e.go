@@ -0,0 +1,262 @@
+package goresult
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"runtime"
+)
+
+// ResultE is like Result, but the error type E is part of the type,
+// so callers can see at compile time which failure domain a function returns.
+type ResultE[T any, E error] interface {
+	Value() T
+	ToAny() any
+	Error() E
+	IsOk() bool
+	IsError() bool
+	IsOkAnd(predicate func(T) bool) bool
+	IsErrorAnd(predicate func(E) bool) bool
+	IsErrorIs(target error) bool
+	Except(msg string) T
+	ExceptError(msg string) E
+	Inspect(f func(T)) ResultE[T, E]
+	InspectError(f func(E)) ResultE[T, E]
+	Unwrap() T
+	UnwrapError() E
+	UnwrapOr(defaults T) T
+	UnwrapOrDefault() T
+	UnwrapOrElse(f func() T) T
+	Option() Option[T]
+	Get() (T, E)
+	Frames() []runtime.Frame
+	Iter() iter.Seq[T]
+	Result() Result[T]
+}
+
+// resultE is a generic type that represents either success (Ok) or failure (Error) with a typed error.
+type resultE[T any, E error] struct {
+	value  T
+	error  E
+	ok     bool
+	frames []uintptr
+}
+
+// OkE returns a typed result that is Ok.
+// example:
+//
+//	OkE[int, *ValidationError](1)
+func OkE[T any, E error](value T) ResultE[T, E] {
+	return &resultE[T, E]{value: value, ok: true}
+}
+
+// ErrorE returns a typed result that is Error.
+// example:
+//
+//	ErrorE[int](&ValidationError{Field: "name"})
+func ErrorE[T any, E error](err E) ResultE[T, E] {
+	r := &resultE[T, E]{error: err}
+	if frameCapture.Load() {
+		r.frames = callers(1)
+	}
+
+	return r
+}
+
+// AsResultE converts r to a typed result.
+// It returns None if r is Error and its error is not of type E, so the conversion never loses the error.
+// example:
+//
+//	r := AsResultE[*ValidationError](Error[int](&ValidationError{Field: "name"}))
+//	fmt.Println(r.Unwrap().UnwrapError().Field)
+//
+// // Output: name
+func AsResultE[E error, T any](r Result[T]) Option[ResultE[T, E]] {
+	if r.IsOk() {
+		return Some(OkE[T, E](r.Value()))
+	}
+
+	if err, ok := r.Error().(E); ok {
+		return Some[ResultE[T, E]](&resultE[T, E]{error: err, frames: framesOf(r)})
+	}
+
+	return None[ResultE[T, E]]()
+}
+
+// Value return value
+func (r *resultE[T, E]) Value() T {
+	return r.value
+}
+
+// ToAny returns the value to any type.
+func (r *resultE[T, E]) ToAny() any {
+	return r.value
+}
+
+// Error return error, the zero value of E if the result is Ok.
+func (r *resultE[T, E]) Error() E {
+	return r.error
+}
+
+// IsOk returns true if the result is Ok.
+func (r *resultE[T, E]) IsOk() bool {
+	return r.ok
+}
+
+// IsError returns true if the result is Error.
+func (r *resultE[T, E]) IsError() bool {
+	return !r.ok
+}
+
+// IsOkAnd returns true if the result is Ok and the value matches predicate.
+func (r *resultE[T, E]) IsOkAnd(predicate func(T) bool) bool {
+	return r.IsOk() && predicate(r.value)
+}
+
+// IsErrorAnd returns true if the result is Error and the error matches predicate.
+func (r *resultE[T, E]) IsErrorAnd(predicate func(E) bool) bool {
+	return r.IsError() && predicate(r.error)
+}
+
+// IsErrorIs returns true if the result is Error and errors.Is(error, target) reports true.
+func (r *resultE[T, E]) IsErrorIs(target error) bool {
+	return r.IsError() && errors.Is(r.error, target)
+}
+
+// Except returns the value if the result is Ok, otherwise it panics with the given message.
+func (r *resultE[T, E]) Except(msg string) T {
+	if r.IsError() {
+		unwrapErrorFailed(msg, r.error)
+	}
+
+	return r.value
+}
+
+// ExceptError returns the error if the result is Error, otherwise it panics with the given message.
+func (r *resultE[T, E]) ExceptError(msg string) E {
+	if r.IsOk() {
+		unwrapValueFailed(msg, r.value)
+	}
+
+	return r.error
+}
+
+// Inspect calls the given function with the value if the result is Ok.
+func (r *resultE[T, E]) Inspect(f func(T)) ResultE[T, E] {
+	if r.IsOk() {
+		f(r.value)
+	}
+
+	return r
+}
+
+// InspectError calls the given function with the error if the result is Error.
+func (r *resultE[T, E]) InspectError(f func(E)) ResultE[T, E] {
+	if r.IsError() {
+		f(r.error)
+	}
+
+	return r
+}
+
+// Unwrap returns the value if the result is Ok, otherwise it panics.
+func (r *resultE[T, E]) Unwrap() T {
+	return r.Except("called `result.Unwrap()` on an `error` value")
+}
+
+// UnwrapError returns the error if the result is Error, otherwise it panics.
+func (r *resultE[T, E]) UnwrapError() E {
+	return r.ExceptError("called `result.UnwrapError()` on an `value` value")
+}
+
+// UnwrapOr returns the value if the result is Ok, otherwise it returns the given default.
+func (r *resultE[T, E]) UnwrapOr(defaults T) T {
+	if r.IsOk() {
+		return r.value
+	}
+
+	return defaults
+}
+
+// UnwrapOrDefault returns the value if the result is Ok, otherwise it returns the default value of the type.
+func (r *resultE[T, E]) UnwrapOrDefault() T {
+	if r.IsOk() {
+		return r.value
+	}
+
+	return resultE[T, E]{}.value
+}
+
+// UnwrapOrElse returns the value if the result is Ok, otherwise it calls and returns the given function.
+func (r *resultE[T, E]) UnwrapOrElse(f func() T) T {
+	if r.IsOk() {
+		return r.value
+	}
+
+	return f()
+}
+
+// Option returns the value as an option.
+// - If the result is Ok, the returned option will be Some(T) with the value.
+// - If the result is Error, the returned option will be None().
+func (r *resultE[T, E]) Option() Option[T] {
+	if r.IsOk() {
+		return Some(r.value)
+	}
+
+	return None[T]()
+}
+
+// Get returns the value and the typed error as a (T, E) pair.
+func (r *resultE[T, E]) Get() (T, E) {
+	return r.value, r.error
+}
+
+// Frames returns the caller frames captured when the Error was created, see SetFrameCapture.
+func (r *resultE[T, E]) Frames() []runtime.Frame {
+	return resolveFrames(r.frames)
+}
+
+// Iter returns an iterator yielding the value if the result is Ok, and nothing otherwise.
+func (r *resultE[T, E]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if r.IsOk() {
+			yield(r.value)
+		}
+	}
+}
+
+// String returns Ok(value) or Error(error), the same as Result.
+func (r *resultE[T, E]) String() string {
+	return r.Result().(fmt.Stringer).String()
+}
+
+// Format implements fmt.Formatter the same way as Result, except %#v prints goresult.ErrorE[T, E](err).
+func (r *resultE[T, E]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		if r.IsOk() {
+			fmt.Fprintf(f, "goresult.OkE[%s, %s](%#v)", typeName[T](), typeName[E](), r.value)
+		} else {
+			fmt.Fprintf(f, "goresult.ErrorE[%s, %s](%#v)", typeName[T](), typeName[E](), r.error)
+		}
+		return
+	}
+
+	r.Result().(fmt.Formatter).Format(f, verb)
+}
+
+// Result converts the typed result to a Result[T], keeping the error and the captured frames.
+// A nil E is converted the same way as Error converts nil, so the result stays an Error.
+// example:
+//
+//	r := ErrorE[int](&ValidationError{Field: "name"}).Result()
+//	fmt.Println(r.Error())
+//
+// // Output: invalid name
+func (r *resultE[T, E]) Result() Result[T] {
+	if r.IsOk() {
+		return Ok(r.value)
+	}
+
+	return &result[T]{error: covertError(r.error), frames: r.frames}
+}
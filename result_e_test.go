@@ -0,0 +1,125 @@
+package goresult
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"slices"
+	"testing"
+)
+
+type testNotFoundError struct {
+	Key string
+}
+
+func (e testNotFoundError) Error() string {
+	return e.Key + " not found"
+}
+
+func Test_ResultE_Ok(t *testing.T) {
+	r := OkE[int, testNotFoundError](1)
+
+	assert.True(t, r.IsOk())
+	assert.False(t, r.IsError())
+	assert.Equal(t, 1, r.Value())
+	assert.Equal(t, 1, r.ToAny())
+	assert.Equal(t, 1, r.Unwrap())
+	assert.Equal(t, testNotFoundError{}, r.Error())
+	assert.Panics(t, func() { r.UnwrapError() })
+	assert.Equal(t, Some(1), r.Option())
+}
+
+func Test_ResultE_Error(t *testing.T) {
+	r := ErrorE[int](testNotFoundError{Key: "user"})
+
+	assert.True(t, r.IsError())
+	assert.Equal(t, "user", r.UnwrapError().Key)
+	assert.Equal(t, "user", r.ExceptError("error").Key)
+	assert.Panics(t, func() { r.Unwrap() })
+	assert.Equal(t, 2, r.UnwrapOr(2))
+	assert.Equal(t, 0, r.UnwrapOrDefault())
+	assert.Equal(t, 3, r.UnwrapOrElse(func() int { return 3 }))
+	assert.Equal(t, None[int](), r.Option())
+
+	v, err := r.Get()
+	assert.Equal(t, 0, v)
+	assert.Equal(t, "user", err.Key)
+}
+
+func Test_ResultE_Inspect(t *testing.T) {
+	var key string
+	count := 0
+
+	OkE[int, testNotFoundError](1).Inspect(func(int) { count++ }).InspectError(func(e testNotFoundError) { key = e.Key })
+	ErrorE[int](testNotFoundError{Key: "user"}).Inspect(func(int) { count++ }).InspectError(func(e testNotFoundError) { key = e.Key })
+
+	assert.Equal(t, 1, count)
+	assert.Equal(t, "user", key)
+}
+
+func Test_ResultE_Result(t *testing.T) {
+	assert.Equal(t, Ok(1), OkE[int, testNotFoundError](1).Result())
+
+	r := ErrorE[int](testNotFoundError{Key: "user"}).Result()
+	var nf testNotFoundError
+	assert.True(t, errors.As(r.Error(), &nf))
+	assert.Equal(t, "user", nf.Key)
+}
+
+func Test_AsResultE(t *testing.T) {
+	assert.Equal(t, Some(OkE[int, testNotFoundError](1)), AsResultE[testNotFoundError](Ok(1)))
+
+	err := testNotFoundError{Key: "user"}
+	assert.Equal(t, Some(ErrorE[int](err)), AsResultE[testNotFoundError](Error[int](err)))
+	assert.Equal(t, None[ResultE[int, testNotFoundError]](), AsResultE[testNotFoundError](Error[int]("error")))
+}
+
+func Test_ResultE_RoundTrip(t *testing.T) {
+	err := testNotFoundError{Key: "user"}
+	r := ErrorE[int](err)
+
+	assert.Equal(t, r, AsResultE[testNotFoundError](r.Result()).Unwrap())
+}
+
+func Test_ResultE_NilError(t *testing.T) {
+	r := ErrorE[int, error](nil)
+
+	assert.True(t, r.IsError())
+	assert.True(t, r.Result().IsError())
+}
+
+func Test_ResultE_Predicates(t *testing.T) {
+	ok := OkE[int, testNotFoundError](1)
+	failed := ErrorE[int](testNotFoundError{Key: "user"})
+	isUser := func(e testNotFoundError) bool { return e.Key == "user" }
+
+	assert.True(t, ok.IsOkAnd(func(v int) bool { return v == 1 }))
+	assert.False(t, failed.IsOkAnd(func(int) bool { return true }))
+	assert.True(t, failed.IsErrorAnd(isUser))
+	assert.False(t, ok.IsErrorAnd(isUser))
+	assert.True(t, failed.IsErrorIs(testNotFoundError{Key: "user"}))
+	assert.False(t, ok.IsErrorIs(testNotFoundError{Key: "user"}))
+}
+
+func Test_ResultE_Iter(t *testing.T) {
+	assert.Equal(t, []int{1}, slices.Collect(OkE[int, testNotFoundError](1).Iter()))
+	assert.Nil(t, slices.Collect(ErrorE[int](testNotFoundError{Key: "user"}).Iter()))
+}
+
+func Test_ResultE_Format(t *testing.T) {
+	assert.Equal(t, "Ok(1)", fmt.Sprint(OkE[int, testNotFoundError](1)))
+	assert.Equal(t, "Error(user not found)", fmt.Sprintf("%v", ErrorE[int](testNotFoundError{Key: "user"})))
+	assert.Equal(t, `goresult.ErrorE[int, goresult.testNotFoundError](goresult.testNotFoundError{Key:"user"})`,
+		fmt.Sprintf("%#v", ErrorE[int](testNotFoundError{Key: "user"})))
+}
+
+func Test_ResultE_Frames(t *testing.T) {
+	withFrameCapture(t)
+
+	r := ErrorE[int](testNotFoundError{Key: "user"})
+	line := r.Frames()[0].Line
+
+	assert.Equal(t, testFramesFunc+"ResultE_Frames", r.Frames()[0].Function)
+	assert.Equal(t, line, r.Result().Frames()[0].Line)
+	assert.Equal(t, line, AsResultE[testNotFoundError](r.Result()).Unwrap().Frames()[0].Line)
+}
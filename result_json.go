@@ -0,0 +1,398 @@
+package goresult
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// errorRegistry maps error names to known errors, so they can be rebuilt when a Result is decoded.
+type errorRegistry struct {
+	mu        sync.RWMutex
+	sentinels map[string]error
+	types     map[string]reflect.Type
+	names     map[reflect.Type]string
+}
+
+var registry = &errorRegistry{
+	sentinels: map[string]error{},
+	types:     map[string]reflect.Type{},
+	names:     map[reflect.Type]string{},
+}
+
+// RegisterError registers a sentinel error under name.
+// A decoded Result carrying name will hold err, so errors.Is(decoded, err) still works.
+// example:
+//
+//	var ErrNotFound = errors.New("not found")
+//
+//	func init() {
+//		RegisterError("not_found", ErrNotFound)
+//	}
+func RegisterError(name string, err error) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	registry.sentinels[name] = err
+}
+
+// RegisterErrorType registers the structured error type E under name.
+// E is encoded with encoding/json and rebuilt on decode, so errors.As(decoded, &e) still works.
+// E must be a concrete type, RegisterErrorType panics if E is an interface type.
+// example:
+//
+//	type ValidationError struct {
+//		Field string `json:"field"`
+//	}
+//
+//	func (e *ValidationError) Error() string { return "invalid " + e.Field }
+//
+//	func init() {
+//		RegisterErrorType[*ValidationError]("validation")
+//	}
+func RegisterErrorType[E error](name string) {
+	t := reflect.TypeOf((*E)(nil)).Elem()
+	if t.Kind() == reflect.Interface {
+		panic(fmt.Errorf("goresult: cannot register interface type %s, register a concrete error type", t))
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	registry.types[name] = t
+	registry.names[t] = name
+}
+
+// lookup returns the name and, for structured errors, the value of the first registered error in the chain of err.
+func (reg *errorRegistry) lookup(err error) (string, error) {
+	reg.mu.RLock()
+	defer reg.mu.RUnlock()
+
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		t := reflect.TypeOf(e)
+		if name, ok := reg.names[t]; ok {
+			return name, e
+		}
+
+		if !t.Comparable() {
+			continue
+		}
+
+		for name, sentinel := range reg.sentinels {
+			if e == sentinel {
+				return name, nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// rebuild returns the error registered under name, decoding data for structured errors.
+func (reg *errorRegistry) rebuild(name string, data json.RawMessage) (error, error) {
+	reg.mu.RLock()
+	defer reg.mu.RUnlock()
+
+	if sentinel, ok := reg.sentinels[name]; ok {
+		return sentinel, nil
+	}
+
+	t, ok := reg.types[name]
+	if !ok {
+		return nil, nil
+	}
+
+	var ptr reflect.Value
+	if t.Kind() == reflect.Pointer {
+		ptr = reflect.New(t.Elem())
+	} else {
+		ptr = reflect.New(t)
+	}
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, ptr.Interface()); err != nil {
+			return nil, fmt.Errorf("decode error type %q: %w", name, err)
+		}
+	}
+
+	if t.Kind() == reflect.Pointer {
+		return ptr.Interface().(error), nil
+	}
+
+	return ptr.Elem().Interface().(error), nil
+}
+
+// remoteError is a decoded error whose message differs from the registered error it wraps.
+type remoteError struct {
+	msg string
+	err error
+}
+
+func (e *remoteError) Error() string {
+	return e.msg
+}
+
+func (e *remoteError) Unwrap() error {
+	return e.err
+}
+
+// remoteErrors is a decoded error that joined several errors, such as a MultiError.
+type remoteErrors struct {
+	msg  string
+	errs []error
+}
+
+func (e *remoteErrors) Error() string {
+	return e.msg
+}
+
+func (e *remoteErrors) Unwrap() []error {
+	return e.errs
+}
+
+type jsonError struct {
+	// Key is the key of an ItemError member of a MultiError, Message being the message of the item error cause.
+	Key     json.RawMessage `json:"key,omitempty"`
+	Message string          `json:"message"`
+	Type    string          `json:"type,omitempty"`
+	Data    json.RawMessage `json:"data,omitempty"`
+	Errors  []*jsonError    `json:"errors,omitempty"`
+}
+
+func encodeError(err error) (*jsonError, error) {
+	if ie, ok := err.(*ItemError); ok {
+		return encodeItemError(ie)
+	}
+
+	je := &jsonError{Message: err.Error()}
+
+	name, structured := registry.lookup(err)
+	je.Type = name
+
+	if structured != nil {
+		data, err := json.Marshal(structured)
+		if err != nil {
+			return nil, err
+		}
+		je.Data = data
+	}
+
+	for _, member := range multiCause(err) {
+		encoded, err := encodeError(member)
+		if err != nil {
+			return nil, err
+		}
+		je.Errors = append(je.Errors, encoded)
+	}
+
+	return je, nil
+}
+
+func encodeItemError(ie *ItemError) (*jsonError, error) {
+	key, err := json.Marshal(ie.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	je, err := encodeError(ie.Err)
+	if err != nil {
+		return nil, err
+	}
+	je.Key = key
+
+	return je, nil
+}
+
+func decodeError(je *jsonError) (error, error) {
+	known, err := registry.rebuild(je.Type, je.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(je.Errors) > 0 {
+		return decodeErrors(je, known)
+	}
+
+	if known == nil {
+		return errors.New(je.Message), nil
+	}
+
+	if known.Error() == je.Message {
+		return known, nil
+	}
+
+	return &remoteError{msg: je.Message, err: known}, nil
+}
+
+// decodeErrors rebuilds an error joining the members of je, keyed members being rebuilt as a MultiError.
+func decodeErrors(je *jsonError, known error) (error, error) {
+	var errs []error
+	if known != nil {
+		errs = append(errs, known)
+	}
+
+	var items MultiError
+	for _, member := range je.Errors {
+		if member == nil {
+			return nil, errors.New("goresult: result envelope has a null error in `errors`")
+		}
+
+		decoded, err := decodeError(member)
+		if err != nil {
+			return nil, err
+		}
+		if member.Key == nil {
+			errs = append(errs, decoded)
+			continue
+		}
+
+		key, err := decodeKey(member.Key)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, &ItemError{Key: key, Err: decoded})
+	}
+
+	if items != nil {
+		if len(errs) == 0 && items.Error() == je.Message {
+			return items, nil
+		}
+		errs = append(errs, items)
+	}
+
+	return &remoteErrors{msg: je.Message, errs: errs}, nil
+}
+
+// decodeKey decodes the key of an ItemError, integers as int, strings as string and any other value as with json.Unmarshal.
+func decodeKey(data json.RawMessage) (any, error) {
+	var index int
+	if err := json.Unmarshal(data, &index); err == nil {
+		return index, nil
+	}
+
+	var key any
+	if err := json.Unmarshal(data, &key); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// MarshalJSON encodes Ok(v) as {"ok": v} and Error(err) as {"error": {"message": ..., "type": ...}}.
+// The type is the name of the first registered error found in the chain of err.
+// The errors joined by a MultiError or errors.Join in the chain of err are encoded in "errors", each in the same form;
+// the members of a MultiError also carry their "key" and are decoded back into a MultiError.
+// Results are immutable, so they cannot be decoded into; use UnmarshalResult or a Res[T] field to decode JSON.
+// example:
+//
+//	b, _ := json.Marshal(Ok(1))
+//	fmt.Println(string(b))
+//
+// // Output: {"ok":1}
+func (r *result[T]) MarshalJSON() ([]byte, error) {
+	return encodeResult(r.value, r.error)
+}
+
+// UnmarshalJSON always fails: results are immutable and may be shared, so they are never decoded in place.
+func (r *result[T]) UnmarshalJSON([]byte) error {
+	return errors.New("goresult: cannot decode into a Result[T], use UnmarshalResult or a Res[T] field")
+}
+
+// MarshalJSON encodes the result the same way as a Result[T].
+// example:
+//
+//	b, _ := json.Marshal(OkE[int, *ValidationError](1))
+//	fmt.Println(string(b))
+//
+// // Output: {"ok":1}
+func (r *resultE[T, E]) MarshalJSON() ([]byte, error) {
+	return encodeResult(r.value, r.Result().Error())
+}
+
+// UnmarshalJSON always fails: results are immutable and may be shared, so they are never decoded in place.
+func (r *resultE[T, E]) UnmarshalJSON([]byte) error {
+	return errors.New("goresult: cannot decode into a ResultE[T, E], use UnmarshalResult and AsResultE")
+}
+
+// MarshalJSON encodes the result the same way as a Result[T].
+func (r Res[T]) MarshalJSON() ([]byte, error) {
+	return encodeResult(r.value, r.error)
+}
+
+// UnmarshalJSON decodes a result envelope produced by MarshalJSON.
+// Errors registered with RegisterError or RegisterErrorType are rebuilt, other errors keep only their message.
+func (r *Res[T]) UnmarshalJSON(data []byte) error {
+	value, err, decodeErr := decodeResult[T](data)
+	if decodeErr != nil {
+		return decodeErr
+	}
+
+	*r = Res[T]{value: value, error: err}
+	return nil
+}
+
+// UnmarshalResult decodes a result envelope produced by MarshalJSON into a Result[T].
+// The returned error reports malformed input, not the error carried by the result.
+// example:
+//
+//	r, err := UnmarshalResult[int]([]byte(`{"ok":1}`))
+//	fmt.Println(r.Unwrap(), err)
+//
+// // Output: 1 <nil>
+func UnmarshalResult[T any](data []byte) (Result[T], error) {
+	value, err, decodeErr := decodeResult[T](data)
+	if decodeErr != nil {
+		return nil, decodeErr
+	}
+
+	return &result[T]{value: value, error: err}, nil
+}
+
+func encodeResult[T any](value T, err error) ([]byte, error) {
+	if err == nil {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(map[string]json.RawMessage{"ok": data})
+	}
+
+	je, err := encodeError(err)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]*jsonError{"error": je})
+}
+
+// decodeResult returns the value and the error carried by a result envelope,
+// and a non-nil decodeErr if the envelope is malformed.
+func decodeResult[T any](data []byte) (value T, err error, decodeErr error) {
+	var envelope map[string]json.RawMessage
+	if decodeErr = json.Unmarshal(data, &envelope); decodeErr != nil {
+		return value, nil, decodeErr
+	}
+
+	if raw, ok := envelope["error"]; ok {
+		var je *jsonError
+		if decodeErr = json.Unmarshal(raw, &je); decodeErr != nil {
+			return value, nil, decodeErr
+		}
+		if je == nil {
+			return value, nil, errors.New("goresult: result envelope has a null `error`")
+		}
+
+		err, decodeErr = decodeError(je)
+		return value, err, decodeErr
+	}
+
+	if raw, ok := envelope["ok"]; ok {
+		decodeErr = json.Unmarshal(raw, &value)
+		return value, nil, decodeErr
+	}
+
+	return value, nil, errors.New("goresult: result envelope has neither `ok` nor `error`")
+}
@@ -0,0 +1,198 @@
+package goresult
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var errTestNotFound = errors.New("not found")
+
+type testValidationError struct {
+	Field string `json:"field"`
+}
+
+func (e *testValidationError) Error() string {
+	return "invalid " + e.Field
+}
+
+func init() {
+	RegisterError("test_not_found", errTestNotFound)
+	RegisterErrorType[*testValidationError]("test_validation")
+}
+
+func Test_Result_MarshalJSON(t *testing.T) {
+	b, err := json.Marshal(Ok(1))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"ok":1}`, string(b))
+
+	b, err = json.Marshal(Error[int]("error"))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"error":{"message":"error"}}`, string(b))
+
+	b, err = json.Marshal(Error[int](errTestNotFound))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"error":{"message":"not found","type":"test_not_found"}}`, string(b))
+
+	b, err = json.Marshal(Error[int](&testValidationError{Field: "name"}))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"error":{"message":"invalid name","type":"test_validation","data":{"field":"name"}}}`, string(b))
+}
+
+func Test_Result_UnmarshalJSON(t *testing.T) {
+	r, err := UnmarshalResult[int]([]byte(`{"ok":1}`))
+	assert.Nil(t, err)
+	assert.Equal(t, Ok(1), r)
+
+	r, err = UnmarshalResult[int]([]byte(`{"error":{"message":"error"}}`))
+	assert.Nil(t, err)
+	assert.EqualError(t, r.Error(), "error")
+
+	_, err = UnmarshalResult[int]([]byte(`{}`))
+	assert.Error(t, err)
+
+	_, err = UnmarshalResult[int]([]byte(`{"ok":"hello"}`))
+	assert.Error(t, err)
+}
+
+func Test_Result_JSON_RoundTrip(t *testing.T) {
+	roundTrip := func(r Result[int]) Result[int] {
+		b, err := json.Marshal(r)
+		assert.Nil(t, err)
+		decoded, err := UnmarshalResult[int](b)
+		assert.Nil(t, err)
+		return decoded
+	}
+
+	assert.Equal(t, Ok(1), roundTrip(Ok(1)))
+
+	decoded := roundTrip(Error[int](errTestNotFound))
+	assert.Equal(t, errTestNotFound, decoded.Error())
+
+	decoded = roundTrip(Error[int](fmt.Errorf("user 1: %w", errTestNotFound)))
+	assert.EqualError(t, decoded.Error(), "user 1: not found")
+	assert.ErrorIs(t, decoded.Error(), errTestNotFound)
+
+	decoded = roundTrip(Error[int](fmt.Errorf("request: %w", &testValidationError{Field: "name"})))
+	assert.EqualError(t, decoded.Error(), "request: invalid name")
+	var ve *testValidationError
+	assert.True(t, errors.As(decoded.Error(), &ve))
+	assert.Equal(t, "name", ve.Field)
+}
+
+func Test_Result_JSON_MultiError(t *testing.T) {
+	r := CollectAll([]Result[int]{Error[int](errTestNotFound), Ok(1), Error[int](&testValidationError{Field: "name"})})
+
+	b, err := json.Marshal(Context(r, "load"))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"error":{"message":"load: [0]: not found; [2]: invalid name","errors":[`+
+		`{"key":0,"message":"not found","type":"test_not_found"},`+
+		`{"key":2,"message":"invalid name","type":"test_validation","data":{"field":"name"}}]}}`, string(b))
+
+	decoded, err := UnmarshalResult[[]int](b)
+	assert.Nil(t, err)
+	assert.EqualError(t, decoded.Error(), "load: [0]: not found; [2]: invalid name")
+	assert.ErrorIs(t, decoded.Error(), errTestNotFound)
+	var ve *testValidationError
+	assert.True(t, errors.As(decoded.Error(), &ve))
+	assert.Equal(t, "name", ve.Field)
+	var errs MultiError
+	assert.True(t, errors.As(decoded.Error(), &errs))
+	assert.Equal(t, []any{0, 2}, errs.Keys())
+
+	b, err = json.Marshal(Invalid[int]("user.name", errTestNotFound).Result())
+	assert.Nil(t, err)
+	invalid, err := UnmarshalResult[int](b)
+	assert.Nil(t, err)
+	assert.Equal(t, MultiError{{Key: "user.name", Err: errTestNotFound}}, invalid.Error())
+
+	joined, err := UnmarshalResult[int]([]byte(`{"error":{"message":"a\nb","errors":[{"message":"a"},{"message":"b"}]}}`))
+	assert.Nil(t, err)
+	assert.EqualError(t, joined.Error(), "a\nb")
+	assert.False(t, errors.As(joined.Error(), &errs))
+
+	_, err = UnmarshalResult[int]([]byte(`{"error":{"message":"error","errors":[null]}}`))
+	assert.Error(t, err)
+}
+
+func Test_RegisterErrorType_Interface(t *testing.T) {
+	assert.PanicsWithError(t, "goresult: cannot register interface type error, register a concrete error type", func() {
+		RegisterErrorType[error]("test_interface")
+	})
+}
+
+func Test_Result_JSON_Nil(t *testing.T) {
+	b, err := json.Marshal(Ok[*int](nil))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"ok":null}`, string(b))
+
+	r, err := UnmarshalResult[*int](b)
+	assert.Nil(t, err)
+	assert.Equal(t, Ok[*int](nil), r)
+
+	m, err := UnmarshalResult[map[string]int]([]byte(`{"ok":null}`))
+	assert.Nil(t, err)
+	assert.True(t, m.IsOk())
+	assert.Nil(t, m.Value())
+
+	_, err = UnmarshalResult[int]([]byte(`{"error":null}`))
+	assert.Error(t, err)
+}
+
+func Test_Res_JSON(t *testing.T) {
+	var payload struct {
+		Result Res[string] `json:"result"`
+	}
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"result":{"ok":"hello"}}`), &payload))
+	assert.Equal(t, Ok("hello"), payload.Result.Result())
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"result":{"error":{"message":"not found","type":"test_not_found"}}}`), &payload))
+	assert.Equal(t, errTestNotFound, payload.Result.Result().Error())
+
+	b, err := json.Marshal(payload)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"result":{"error":{"message":"not found","type":"test_not_found"}}}`, string(b))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"result":{}}`), &payload))
+}
+
+func Test_Result_UnmarshalJSON_Immutable(t *testing.T) {
+	var payload struct {
+		Result Result[string] `json:"result"`
+	}
+
+	r := Ok("")
+	payload.Result = r
+	assert.Error(t, json.Unmarshal([]byte(`{"result":{"ok":"hello"}}`), &payload))
+	assert.Equal(t, Ok(""), r)
+}
+
+func Test_ResultE_JSON(t *testing.T) {
+	b, err := json.Marshal(OkE[int, *testValidationError](1))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"ok":1}`, string(b))
+
+	b, err = json.Marshal(ErrorE[int](&testValidationError{Field: "name"}))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"error":{"message":"invalid name","type":"test_validation","data":{"field":"name"}}}`, string(b))
+
+	decoded, err := UnmarshalResult[int](b)
+	assert.Nil(t, err)
+	assert.Equal(t, "name", AsResultE[*testValidationError](decoded).Unwrap().UnwrapError().Field)
+
+	var r struct {
+		R ResultE[int, *testValidationError]
+	}
+	r.R = OkE[int, *testValidationError](1)
+	assert.Error(t, json.Unmarshal([]byte(`{"R":{"ok":2}}`), &r))
+	assert.Equal(t, OkE[int, *testValidationError](1), r.R)
+}
+
+func Test_ResOf(t *testing.T) {
+	assert.Equal(t, Ok(1), ResOf(Ok(1)).Result())
+	assert.Equal(t, Error[int](errTestNotFound), ResOf(Error[int](errTestNotFound)).Result())
+	assert.Equal(t, Ok(0), Res[int]{}.Result())
+}
@@ -3,7 +3,9 @@ package goresult
 import (
 	"fmt"
 	"github.com/stretchr/testify/assert"
+	"io/fs"
 	"math/rand"
+	"os"
 	"reflect"
 	"strconv"
 	"testing"
@@ -280,6 +282,123 @@ func Test_Result_Option(t *testing.T) {
 	assert.Equal(t, None[[]byte](), Error[[]byte]("error").Option())
 }
 
+func Test_Result_From(t *testing.T) {
+	assert.Equal(t, Ok(42), From(strconv.Atoi("42")))
+
+	r := From(strconv.Atoi("hello"))
+	assert.True(t, r.IsError())
+	assert.Equal(t, 0, r.Value())
+}
+
+func Test_Result_FromFunc(t *testing.T) {
+	assert.Equal(t, Ok(42), FromFunc(func() (int, error) { return 42, nil }))
+
+	err := fmt.Errorf("error")
+	assert.Equal(t, Error[int](err), FromFunc(func() (int, error) { return 0, err }))
+}
+
+func Test_Result_Get(t *testing.T) {
+	v, err := Ok(42).Get()
+	assert.Equal(t, 42, v)
+	assert.Nil(t, err)
+
+	v, err = Error[int]("error").Get()
+	assert.Equal(t, 0, v)
+	assert.EqualError(t, err, "error")
+}
+
+func Test_Result_IsOkAnd(t *testing.T) {
+	positive := func(v int) bool { return v > 0 }
+
+	assert.True(t, Ok(1).IsOkAnd(positive))
+	assert.False(t, Ok(-1).IsOkAnd(positive))
+	assert.False(t, Error[int]("error").IsOkAnd(positive))
+}
+
+func Test_Result_IsErrorAnd(t *testing.T) {
+	assert.True(t, Error[int](os.ErrNotExist).IsErrorAnd(os.IsNotExist))
+	assert.False(t, Error[int]("error").IsErrorAnd(os.IsNotExist))
+	assert.False(t, Ok(1).IsErrorAnd(func(error) bool { return true }))
+}
+
+func Test_Result_IsErrorIs(t *testing.T) {
+	assert.True(t, Error[int](fmt.Errorf("load: %w", os.ErrNotExist)).IsErrorIs(os.ErrNotExist))
+	assert.False(t, Error[int]("error").IsErrorIs(os.ErrNotExist))
+	assert.False(t, Ok(1).IsErrorIs(os.ErrNotExist))
+}
+
+func Test_ErrorAs(t *testing.T) {
+	pathErr := &fs.PathError{Op: "open", Path: "config.json", Err: fs.ErrNotExist}
+
+	assert.Equal(t, Some(pathErr), ErrorAs[*fs.PathError](Error[int](fmt.Errorf("load: %w", pathErr))))
+	assert.Equal(t, None[*fs.PathError](), ErrorAs[*fs.PathError](Error[int]("error")))
+	assert.Equal(t, None[*fs.PathError](), ErrorAs[*fs.PathError](Ok(1)))
+}
+
+func Test_Result_Map(t *testing.T) {
+	assert.Equal(t, Ok("42"), Map(Ok(42), strconv.Itoa))
+
+	err := fmt.Errorf("error")
+	assert.Equal(t, Error[string](err), Map(Error[int](err), strconv.Itoa))
+}
+
+func Test_Result_MapErr(t *testing.T) {
+	wrap := func(err error) error { return fmt.Errorf("wrapped: %w", err) }
+	assert.Equal(t, Ok(42), MapErr(Ok(42), wrap))
+
+	err := fmt.Errorf("error")
+	r := MapErr(Error[int](err), wrap)
+	assert.Equal(t, "wrapped: error", r.Error().Error())
+	assert.ErrorIs(t, r.Error(), err)
+}
+
+func Test_Result_MapOr(t *testing.T) {
+	assert.Equal(t, "42", MapOr(Ok(42), "default", strconv.Itoa))
+	assert.Equal(t, "default", MapOr(Error[int]("error"), "default", strconv.Itoa))
+}
+
+func Test_Result_MapOrElse(t *testing.T) {
+	fallback := func(err error) string { return err.Error() }
+	assert.Equal(t, "42", MapOrElse(Ok(42), fallback, strconv.Itoa))
+	assert.Equal(t, "error", MapOrElse(Error[int]("error"), fallback, strconv.Itoa))
+}
+
+func Test_Result_AndThen(t *testing.T) {
+	atoi := func(v string) Result[int] {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return Error[int](err)
+		}
+		return Ok(i)
+	}
+
+	assert.Equal(t, Ok(42), AndThen(Ok("42"), atoi))
+	assert.True(t, AndThen(Ok("hello"), atoi).IsError())
+
+	err := fmt.Errorf("error")
+	assert.Equal(t, Error[int](err), AndThen(Error[string](err), atoi))
+}
+
+func Test_Result_And(t *testing.T) {
+	err := fmt.Errorf("error")
+	assert.Equal(t, Ok("hello"), And(Ok(42), Ok("hello")))
+	assert.Equal(t, Error[string](err), And(Ok(42), Error[string](err)))
+	assert.Equal(t, Error[string](err), And(Error[int](err), Ok("hello")))
+}
+
+func Test_Result_Or(t *testing.T) {
+	err := fmt.Errorf("error")
+	assert.Equal(t, Ok(42), Or(Ok(42), Ok(0)))
+	assert.Equal(t, Ok(0), Or(Error[int](err), Ok(0)))
+	assert.Equal(t, Error[int](err), Or(Error[int]("first"), Error[int](err)))
+}
+
+func Test_Result_OrElse(t *testing.T) {
+	fallback := func(err error) Result[int] { return Ok(len(err.Error())) }
+	assert.Equal(t, Ok(42), OrElse(Ok(42), fallback))
+	assert.Equal(t, Ok(5), OrElse(Error[int]("error"), fallback))
+}
+
 func Test_unwrapErrorFailed(t *testing.T) {
 	assert.Panics(t, func() {
 		unwrapErrorFailed[error]("err", fmt.Errorf("error"))
@@ -0,0 +1,199 @@
+package goresult
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// Clock is the source of time used by Retry, it can be replaced to test retries deterministically.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// DefaultMaxAttempts is the number of attempts made by Retry when the policy limits neither attempts nor elapsed time.
+const DefaultMaxAttempts = 10
+
+// maxDuration is the longest delay a Backoff returns, so delays saturate instead of overflowing.
+const maxDuration = time.Duration(math.MaxInt64)
+
+// Backoff returns the delay before the next attempt, given the number of the failed attempt (starting at 1),
+// the previous delay (zero after the first attempt) and the random source of the RetryPolicy.
+type Backoff func(attempt int, prev time.Duration, rnd *rand.Rand) time.Duration
+
+// ConstantBackoff waits d between every attempt.
+func ConstantBackoff(d time.Duration) Backoff {
+	return func(int, time.Duration, *rand.Rand) time.Duration {
+		return d
+	}
+}
+
+// ExponentialBackoff waits base, then doubles the delay after every attempt, up to maxDelay.
+// A maxDelay of zero or less means no limit other than the largest time.Duration.
+// example:
+//
+//	ExponentialBackoff(100*time.Millisecond, 10*time.Second) // 100ms, 200ms, 400ms, ...
+func ExponentialBackoff(base, maxDelay time.Duration) Backoff {
+	if maxDelay <= 0 {
+		maxDelay = maxDuration
+	}
+
+	return func(attempt int, _ time.Duration, _ *rand.Rand) time.Duration {
+		d := base
+		for i := 1; i < attempt; i++ {
+			if d >= maxDelay/2 {
+				return maxDelay
+			}
+			d *= 2
+		}
+
+		if d > maxDelay {
+			return maxDelay
+		}
+
+		return d
+	}
+}
+
+// DecorrelatedJitterBackoff waits a random delay between base and three times the previous delay, up to maxDelay.
+// A maxDelay of zero or less means no limit other than the largest time.Duration.
+// The delay is drawn from the Rand source of the RetryPolicy, so it can be made deterministic.
+func DecorrelatedJitterBackoff(base, maxDelay time.Duration) Backoff {
+	if maxDelay <= 0 {
+		maxDelay = maxDuration
+	}
+
+	return func(_ int, prev time.Duration, rnd *rand.Rand) time.Duration {
+		upper := max(prev, base)
+		if upper > maxDelay/3 {
+			upper = maxDelay
+		} else {
+			upper = min(3*upper, maxDelay)
+		}
+		if upper <= base {
+			return upper
+		}
+
+		return base + time.Duration(rnd.Int64N(int64(upper-base)))
+	}
+}
+
+// RetryPolicy controls how Retry repeats a failing function.
+type RetryPolicy struct {
+	// Backoff returns the delay between attempts, no delay if nil.
+	Backoff Backoff
+	// MaxAttempts is the maximum number of attempts, no limit if zero or less.
+	// If neither MaxAttempts nor MaxElapsed is set, DefaultMaxAttempts is used.
+	MaxAttempts int
+	// MaxElapsed stops retrying when the next attempt would start after MaxElapsed, no limit if zero or less.
+	MaxElapsed time.Duration
+	// Retryable reports whether an error is worth retrying, every error is retried if nil.
+	Retryable func(error) bool
+	// Clock is the source of time, the system clock if nil.
+	Clock Clock
+	// Rand is the random source passed to Backoff, a randomly seeded source if nil.
+	Rand rand.Source
+}
+
+// RetryIf returns a Retryable predicate matching errors for which errors.Is reports one of targets.
+// example:
+//
+//	policy := RetryPolicy{Retryable: RetryIf(ErrUnavailable, context.DeadlineExceeded)}
+func RetryIf(targets ...error) func(error) bool {
+	return func(err error) bool {
+		for _, target := range targets {
+			if errors.Is(err, target) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// RetryIfAs returns a Retryable predicate matching errors for which errors.As finds an E.
+// example:
+//
+//	policy := RetryPolicy{Retryable: RetryIfAs[*net.OpError]()}
+func RetryIfAs[E error]() func(error) bool {
+	return func(err error) bool {
+		var target E
+		return errors.As(err, &target)
+	}
+}
+
+// Retry calls f until it returns Ok, the error is not retryable, or the policy limits are reached.
+// When it gives up, the returned Error joins the errors of every attempt with errors.Join.
+// If ctx is done while waiting, its error is joined as well.
+// example:
+//
+//	r := Retry(ctx, RetryPolicy{
+//		Backoff:     ExponentialBackoff(100*time.Millisecond, 5*time.Second),
+//		MaxAttempts: 5,
+//	}, func(ctx context.Context) Result[User] {
+//		return fetchUser(ctx, id)
+//	})
+func Retry[T any](ctx context.Context, policy RetryPolicy, f func(context.Context) Result[T]) Result[T] {
+	clock := policy.Clock
+	if clock == nil {
+		clock = systemClock{}
+	}
+
+	maxAttempts := policy.MaxAttempts
+	if maxAttempts <= 0 && policy.MaxElapsed <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+
+	source := policy.Rand
+	if source == nil {
+		source = rand.NewPCG(rand.Uint64(), rand.Uint64())
+	}
+	rnd := rand.New(source)
+
+	start := clock.Now()
+	var errs []error
+	var delay time.Duration
+
+	for attempt := 1; ; attempt++ {
+		r := f(ctx)
+		if r.IsOk() {
+			return r
+		}
+
+		errs = append(errs, r.Error())
+		if policy.Retryable != nil && !policy.Retryable(r.Error()) {
+			break
+		}
+		if maxAttempts > 0 && attempt >= maxAttempts {
+			break
+		}
+
+		if policy.Backoff != nil {
+			delay = policy.Backoff(attempt, delay, rnd)
+		}
+		if policy.MaxElapsed > 0 && delay > policy.MaxElapsed-clock.Now().Sub(start) {
+			break
+		}
+
+		select {
+		case <-clock.After(delay):
+		case <-ctx.Done():
+			errs = append(errs, ctx.Err())
+			return newError[T](errors.Join(errs...), 1)
+		}
+	}
+
+	if len(errs) == 1 {
+		return newError[T](errs[0], 1)
+	}
+
+	return newError[T](errors.Join(errs...), 1)
+}
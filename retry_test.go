@@ -0,0 +1,179 @@
+package goresult
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/fs"
+	"math"
+	"math/rand/v2"
+	"testing"
+	"time"
+)
+
+// testClock is a Clock whose time only advances when waiting.
+type testClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) After(d time.Duration) <-chan time.Time {
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+func failing(n int, err error) (func(context.Context) Result[int], *int) {
+	calls := 0
+	return func(context.Context) Result[int] {
+		calls++
+		if calls <= n {
+			return Error[int](fmt.Errorf("attempt %d: %w", calls, err))
+		}
+		return Ok(calls)
+	}, &calls
+}
+
+func Test_Retry(t *testing.T) {
+	clock := &testClock{}
+	f, calls := failing(2, fs.ErrNotExist)
+
+	r := Retry(context.Background(), RetryPolicy{Backoff: ConstantBackoff(time.Second), Clock: clock}, f)
+
+	assert.Equal(t, Ok(3), r)
+	assert.Equal(t, 3, *calls)
+	assert.Equal(t, []time.Duration{time.Second, time.Second}, clock.sleeps)
+}
+
+func Test_Retry_MaxAttempts(t *testing.T) {
+	clock := &testClock{}
+	f, calls := failing(10, fs.ErrNotExist)
+
+	r := Retry(context.Background(), RetryPolicy{MaxAttempts: 3, Clock: clock}, f)
+
+	assert.Equal(t, 3, *calls)
+	assert.EqualError(t, r.Error(), "attempt 1: file does not exist\nattempt 2: file does not exist\nattempt 3: file does not exist")
+	assert.ErrorIs(t, r.Error(), fs.ErrNotExist)
+}
+
+func Test_Retry_MaxElapsed(t *testing.T) {
+	clock := &testClock{}
+	f, calls := failing(10, fs.ErrNotExist)
+
+	Retry(context.Background(), RetryPolicy{
+		Backoff:    ConstantBackoff(time.Second),
+		MaxElapsed: 2500 * time.Millisecond,
+		Clock:      clock,
+	}, f)
+
+	assert.Equal(t, 3, *calls)
+	assert.Equal(t, []time.Duration{time.Second, time.Second}, clock.sleeps)
+}
+
+func Test_Retry_Retryable(t *testing.T) {
+	f, calls := failing(10, fs.ErrPermission)
+	r := Retry(context.Background(), RetryPolicy{Retryable: RetryIf(fs.ErrNotExist), Clock: &testClock{}}, f)
+
+	assert.Equal(t, 1, *calls)
+	assert.EqualError(t, r.Error(), "attempt 1: permission denied")
+
+	f, calls = failing(2, fs.ErrNotExist)
+	r = Retry(context.Background(), RetryPolicy{Retryable: RetryIf(fs.ErrNotExist), Clock: &testClock{}}, f)
+	assert.Equal(t, Ok(3), r)
+}
+
+func Test_Retry_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	f, calls := failing(10, fs.ErrNotExist)
+	r := Retry(ctx, RetryPolicy{Backoff: ConstantBackoff(time.Hour)}, f)
+
+	assert.Equal(t, 1, *calls)
+	assert.ErrorIs(t, r.Error(), context.Canceled)
+	assert.ErrorIs(t, r.Error(), fs.ErrNotExist)
+}
+
+func Test_RetryIfAs(t *testing.T) {
+	retryable := RetryIfAs[*fs.PathError]()
+
+	assert.True(t, retryable(fmt.Errorf("load: %w", &fs.PathError{Err: fs.ErrNotExist})))
+	assert.False(t, retryable(errors.New("error")))
+}
+
+func Test_ExponentialBackoff(t *testing.T) {
+	backoff := ExponentialBackoff(100*time.Millisecond, time.Second)
+
+	var delays []time.Duration
+	for attempt := 1; attempt <= 6; attempt++ {
+		delays = append(delays, backoff(attempt, 0, nil))
+	}
+
+	assert.Equal(t, []time.Duration{
+		100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond,
+		800 * time.Millisecond, time.Second, time.Second,
+	}, delays)
+	assert.Equal(t, 100*time.Millisecond<<10, ExponentialBackoff(100*time.Millisecond, 0)(11, 0, nil))
+}
+
+func Test_ExponentialBackoff_Saturate(t *testing.T) {
+	backoff := ExponentialBackoff(time.Second, 0)
+
+	assert.Equal(t, time.Second<<33, backoff(34, 0, nil))
+	assert.Equal(t, time.Duration(math.MaxInt64), backoff(35, 0, nil))
+	assert.Equal(t, time.Duration(math.MaxInt64), backoff(70, 0, nil))
+
+	jitter := DecorrelatedJitterBackoff(time.Second, 0)(1, math.MaxInt64/2, rand.New(rand.NewPCG(1, 2)))
+	assert.GreaterOrEqual(t, jitter, time.Second)
+}
+
+func Test_DecorrelatedJitterBackoff(t *testing.T) {
+	backoff := DecorrelatedJitterBackoff(100*time.Millisecond, time.Second)
+	rnd := rand.New(rand.NewPCG(1, 2))
+
+	var prev time.Duration
+	for attempt := 1; attempt <= 100; attempt++ {
+		d := backoff(attempt, prev, rnd)
+		assert.GreaterOrEqual(t, d, 100*time.Millisecond)
+		assert.LessOrEqual(t, d, time.Second)
+		if prev > 0 {
+			assert.LessOrEqual(t, d, 3*prev)
+		}
+		prev = d
+	}
+}
+
+func Test_Retry_Rand(t *testing.T) {
+	run := func() []time.Duration {
+		clock := &testClock{}
+		f, _ := failing(10, fs.ErrNotExist)
+		Retry(context.Background(), RetryPolicy{
+			Backoff:     DecorrelatedJitterBackoff(100*time.Millisecond, time.Minute),
+			MaxAttempts: 5,
+			Clock:       clock,
+			Rand:        rand.NewPCG(1, 2),
+		}, f)
+		return clock.sleeps
+	}
+
+	sleeps := run()
+	assert.Len(t, sleeps, 4)
+	assert.Equal(t, sleeps, run())
+}
+
+func Test_Retry_DefaultMaxAttempts(t *testing.T) {
+	f, calls := failing(100, fs.ErrNotExist)
+
+	r := Retry(context.Background(), RetryPolicy{}, f)
+
+	assert.Equal(t, DefaultMaxAttempts, *calls)
+	assert.ErrorIs(t, r.Error(), fs.ErrNotExist)
+}
@@ -0,0 +1,126 @@
+package goresult
+
+// Collect returns Ok with all values if every result is Ok, otherwise the first Error.
+// This is also known as sequence.
+// example:
+//
+//	r := Collect([]Result[int]{Ok(1), Ok(2)})
+//	fmt.Println(r.Unwrap())
+//
+// // Output: [1 2]
+func Collect[T any](results []Result[T]) Result[[]T] {
+	values := make([]T, 0, len(results))
+	for _, r := range results {
+		if r.IsError() {
+			return errorOf[[]T](r)
+		}
+		values = append(values, r.Value())
+	}
+
+	return Ok(values)
+}
+
+// CollectAll returns Ok with all values if every result is Ok,
+// otherwise an Error(MultiError) recording the index of every Error result.
+// example:
+//
+//	r := CollectAll([]Result[int]{Error[int]("a"), Ok(1), Error[int]("b")})
+//	fmt.Println(r.Error())
+//
+// // Output: [0]: a; [2]: b
+func CollectAll[T any](results []Result[T]) Result[[]T] {
+	values := make([]T, 0, len(results))
+	var errs MultiError
+	for i, r := range results {
+		if r.IsError() {
+			errs = append(errs, &ItemError{Key: i, Err: r.Error()})
+			continue
+		}
+		values = append(values, r.Value())
+	}
+
+	if len(errs) > 0 {
+		return newError[[]T](errs, 1)
+	}
+
+	return Ok(values)
+}
+
+// Partition splits results into the values of the Ok results and the errors of the Error results, keeping their order.
+// example:
+//
+//	oks, errs := Partition([]Result[int]{Ok(1), Error[int]("a"), Ok(2)})
+//	fmt.Println(oks, errs)
+//
+// // Output: [1 2] [a]
+func Partition[T any](results []Result[T]) ([]T, []error) {
+	var values []T
+	var errs []error
+	for _, r := range results {
+		if r.IsError() {
+			errs = append(errs, r.Error())
+			continue
+		}
+		values = append(values, r.Value())
+	}
+
+	return values, errs
+}
+
+// Traverse calls f with each element of xs and collects the values, stopping at the first Error.
+// example:
+//
+//	r := Traverse([]string{"1", "2"}, func(s string) Result[int] {
+//		return From(strconv.Atoi(s))
+//	})
+//	fmt.Println(r.Unwrap())
+//
+// // Output: [1 2]
+func Traverse[A, B any](xs []A, f func(A) Result[B]) Result[[]B] {
+	values := make([]B, 0, len(xs))
+	for _, x := range xs {
+		r := f(x)
+		if r.IsError() {
+			return errorOf[[]B](r)
+		}
+		values = append(values, r.Value())
+	}
+
+	return Ok(values)
+}
+
+// CollectOpt returns Some with all values if every option is Some, otherwise None.
+// example:
+//
+//	opt := CollectOpt([]Option[int]{Some(1), None[int]()})
+//	fmt.Println(opt.IsNone())
+//
+// // Output: true
+func CollectOpt[T any](opts []Option[T]) Option[[]T] {
+	values := make([]T, 0, len(opts))
+	for _, opt := range opts {
+		if opt.IsNone() {
+			return None[[]T]()
+		}
+		values = append(values, opt.Value())
+	}
+
+	return Some(values)
+}
+
+// FilterSome returns the values of the Some options, dropping every None.
+// example:
+//
+//	fmt.Println(FilterSome([]Option[int]{Some(1), None[int](), Some(2)}))
+//
+// // Output: [1 2]
+func FilterSome[T any](opts []Option[T]) []T {
+	var values []T
+	for _, opt := range opts {
+		if opt.IsSome() {
+			values = append(values, opt.Value())
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,65 @@
+package goresult
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func Test_Collect(t *testing.T) {
+	err := fmt.Errorf("error")
+
+	assert.Equal(t, Ok([]int{1, 2}), Collect([]Result[int]{Ok(1), Ok(2)}))
+	assert.Equal(t, Ok([]int{}), Collect([]Result[int]{}))
+	assert.Equal(t, Error[[]int](err), Collect([]Result[int]{Ok(1), Error[int](err), Error[int]("other")}))
+}
+
+func Test_CollectAll(t *testing.T) {
+	errA, errB := fmt.Errorf("a"), fmt.Errorf("b")
+
+	assert.Equal(t, Ok([]int{1, 2}), CollectAll([]Result[int]{Ok(1), Ok(2)}))
+
+	r := CollectAll([]Result[int]{Error[int](errA), Ok(1), Error[int](errB)})
+	assert.EqualError(t, r.Error(), "[0]: a; [2]: b")
+	assert.True(t, errors.Is(r.Error(), errA))
+	assert.True(t, errors.Is(r.Error(), errB))
+}
+
+func Test_Partition(t *testing.T) {
+	errA := fmt.Errorf("a")
+
+	oks, errs := Partition([]Result[int]{Ok(1), Error[int](errA), Ok(2)})
+	assert.Equal(t, []int{1, 2}, oks)
+	assert.Equal(t, []error{errA}, errs)
+
+	oks, errs = Partition([]Result[int]{})
+	assert.Nil(t, oks)
+	assert.Nil(t, errs)
+}
+
+func Test_Traverse(t *testing.T) {
+	calls := 0
+	atoi := func(s string) Result[int] {
+		calls++
+		return From(strconv.Atoi(s))
+	}
+
+	assert.Equal(t, Ok([]int{1, 2}), Traverse([]string{"1", "2"}, atoi))
+
+	calls = 0
+	r := Traverse([]string{"1", "a", "2"}, atoi)
+	assert.True(t, r.IsError())
+	assert.Equal(t, 2, calls)
+}
+
+func Test_CollectOpt(t *testing.T) {
+	assert.Equal(t, Some([]int{1, 2}), CollectOpt([]Option[int]{Some(1), Some(2)}))
+	assert.Equal(t, None[[]int](), CollectOpt([]Option[int]{Some(1), None[int]()}))
+}
+
+func Test_FilterSome(t *testing.T) {
+	assert.Equal(t, []int{1, 2}, FilterSome([]Option[int]{Some(1), None[int](), Some(2)}))
+	assert.Nil(t, FilterSome([]Option[int]{None[int]()}))
+}
@@ -0,0 +1,96 @@
+package goresult
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError is the error produced when Try or TryResult recovers a panic.
+// It keeps the recovered value and the stack trace of the panicking goroutine.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+// Error returns the recovered value formatted as a panic message.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the recovered value if it is an error, so errors.Is and errors.As see through the panic.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+// Try calls f and returns Ok with its return value.
+// If f panics, the panic is recovered and returned as Error(*PanicError).
+// example:
+//
+//	result := Try(func() int {
+//		return 1
+//	})
+//	fmt.Println(result.Unwrap())
+//
+// // Output: 1
+//
+//	result := Try(func() int {
+//		panic("something went wrong")
+//	})
+//	fmt.Println(result.Error())
+//
+// // Output: panic: something went wrong
+func Try[T any](f func() T) Result[T] {
+	return TryResult(func() Result[T] {
+		return Ok(f())
+	})
+}
+
+// TryResult calls f and returns its result.
+// If f panics, the panic is recovered and returned as Error(*PanicError).
+// A Must short-circuiting an enclosing Do block is not a panic and is passed through to the Do call.
+// example:
+//
+//	result := TryResult(func() Result[int] {
+//		return Error[int]("something went wrong")
+//	})
+//	fmt.Println(result.Error())
+//
+// // Output: something went wrong
+func TryResult[T any](f func() Result[T]) (r Result[T]) {
+	defer func() {
+		if v := recover(); v != nil {
+			r = recovered[T](v, false)
+		}
+	}()
+
+	return f()
+}
+
+// goResult is TryResult for f running in its own goroutine, where the Do call owning a Scope cannot recover
+// the sentinel raised by Must. The sentinel settles into the error passed to Must instead.
+func goResult[T any](f func() Result[T]) (r Result[T]) {
+	defer func() {
+		if v := recover(); v != nil {
+			r = recovered[T](v, true)
+		}
+	}()
+
+	return f()
+}
+
+// recovered returns the Error for a value recovered from a panic.
+// The sentinel raised by Must is raised again so only the owning Do recovers it, unless settle is set.
+func recovered[T any](v any, settle bool) Result[T] {
+	if exit, ok := v.(*scopeExit); ok {
+		if !settle {
+			panic(exit)
+		}
+		return &result[T]{error: exit.err, frames: exit.frames}
+	}
+
+	return Error[T](&PanicError{Value: v, Stack: debug.Stack()})
+}
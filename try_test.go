@@ -0,0 +1,53 @@
+package goresult
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_Try(t *testing.T) {
+	assert.Equal(t, Ok(42), Try(func() int { return 42 }))
+
+	r := Try(func() int { panic("boom") })
+	assert.True(t, r.IsError())
+
+	var pe *PanicError
+	assert.True(t, errors.As(r.Error(), &pe))
+	assert.Equal(t, "boom", pe.Value)
+	assert.NotEmpty(t, pe.Stack)
+	assert.EqualError(t, r.Error(), "panic: boom")
+}
+
+func Test_Try_PanicError(t *testing.T) {
+	err := fmt.Errorf("error")
+	r := Try(func() int { panic(err) })
+
+	assert.ErrorIs(t, r.Error(), err)
+}
+
+func Test_Try_UnwrapPanic(t *testing.T) {
+	r := Try(func() int { return Error[int]("error").Unwrap() })
+
+	assert.True(t, r.IsError())
+	assert.Contains(t, r.Error().Error(), "called `result.Unwrap()` on an `error` value")
+}
+
+func Test_TryResult(t *testing.T) {
+	assert.Equal(t, Ok(42), TryResult(func() Result[int] { return Ok(42) }))
+
+	err := fmt.Errorf("error")
+	assert.Equal(t, Error[int](err), TryResult(func() Result[int] { return Error[int](err) }))
+
+	r := TryResult(func() Result[int] { panic("boom") })
+	var pe *PanicError
+	assert.True(t, errors.As(r.Error(), &pe))
+}
+
+func Test_PanicError_Unwrap(t *testing.T) {
+	assert.Nil(t, (&PanicError{Value: "boom"}).Unwrap())
+
+	err := fmt.Errorf("error")
+	assert.Equal(t, err, (&PanicError{Value: err}).Unwrap())
+}
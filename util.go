@@ -2,6 +2,12 @@ package goresult
 
 import "fmt"
 
+// Pair holds two values of possibly different types, as produced by ZipOpt.
+type Pair[T, U any] struct {
+	First  T
+	Second U
+}
+
 func covertError(err interface{}) error {
 	switch err.(type) {
 	case error:
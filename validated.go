@@ -0,0 +1,203 @@
+package goresult
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type Validated[T any] interface {
+	Value() T
+	IsValid() bool
+	IsInvalid() bool
+	Errors() MultiError
+	Unwrap() T
+	At(path string) Validated[T]
+	Result() Result[T]
+}
+
+// validated is either a valid value or every error found while validating it.
+// Every error is an ItemError keyed by the path of the invalid field, such as "user.emails[0]".
+type validated[T any] struct {
+	value  T
+	errors MultiError
+}
+
+// Valid returns a validated value with no errors.
+func Valid[T any](value T) Validated[T] {
+	return &validated[T]{value: value}
+}
+
+// Invalid returns a validated value failing with err for the field at path.
+// err is converted the same way as Error converts its argument.
+// example:
+//
+//	Invalid[string]("name", "must not be empty")
+func Invalid[T any](path string, err interface{}) Validated[T] {
+	return &validated[T]{errors: MultiError{{Key: path, Err: covertError(err)}}}
+}
+
+// Validate runs every check against value and reports every failing check for the field at path.
+// example:
+//
+//	name := Validate(input.Name, "name", notEmpty, maxLength(64))
+func Validate[T any](value T, path string, checks ...func(T) error) Validated[T] {
+	v := &validated[T]{value: value}
+	for _, check := range checks {
+		if err := check(value); err != nil {
+			v.errors = append(v.errors, &ItemError{Key: path, Err: err})
+		}
+	}
+
+	return v
+}
+
+// ValidatedFrom converts r to a validated value. An Error carrying a MultiError, such as one returned by CollectAll
+// or Validated.Result, keeps every item error with its key nested under path,
+// any other error is reported for the field at path.
+// example:
+//
+//	port := ValidatedFrom(From(strconv.Atoi(input.Port)), "port")
+func ValidatedFrom[T any](r Result[T], path string) Validated[T] {
+	if r.IsOk() {
+		return Valid(r.Value())
+	}
+
+	var errs MultiError
+	if errors.As(r.Error(), &errs) {
+		return (&validated[T]{errors: errs}).At(path)
+	}
+
+	return Invalid[T](path, r.Error())
+}
+
+// Value returns the value, which may be partially filled if the validated value is invalid.
+func (v *validated[T]) Value() T {
+	return v.value
+}
+
+// IsValid returns true if no error was reported.
+func (v *validated[T]) IsValid() bool {
+	return len(v.errors) == 0
+}
+
+// IsInvalid returns true if at least one error was reported.
+func (v *validated[T]) IsInvalid() bool {
+	return len(v.errors) > 0
+}
+
+// Errors returns every reported error keyed by its field path, nil if the validated value is valid.
+func (v *validated[T]) Errors() MultiError {
+	return v.errors
+}
+
+// Unwrap returns the value if it is valid, otherwise it panics.
+func (v *validated[T]) Unwrap() T {
+	if v.IsInvalid() {
+		unwrapErrorFailed("called `validated.Unwrap()` on an `invalid` value", v.errors)
+	}
+
+	return v.value
+}
+
+// At prefixes the path of every error with path, to nest the validation of a field.
+// Keys that are not paths, such as the indexes reported by CollectAll, become index paths.
+// example:
+//
+//	Invalid[string]("name", "must not be empty").At("user") // user.name: must not be empty
+func (v *validated[T]) At(path string) Validated[T] {
+	if v.IsValid() || path == "" {
+		return v
+	}
+
+	errs := make(MultiError, len(v.errors))
+	for i, err := range v.errors {
+		errs[i] = &ItemError{Key: joinPath(path, keyPath(err.Key)), Err: err.Err}
+	}
+
+	return &validated[T]{value: v.value, errors: errs}
+}
+
+// Result returns Ok(value) if it is valid, otherwise Error(MultiError).
+func (v *validated[T]) Result() Result[T] {
+	if v.IsValid() {
+		return Ok(v.value)
+	}
+
+	return newError[T](v.errors, 1)
+}
+
+// Map2 calls f with the values if a and b are valid, otherwise returns every error of both.
+// example:
+//
+//	user := Map2(
+//		Validate(input.Name, "name", notEmpty),
+//		ValidatedFrom(From(strconv.Atoi(input.Age)), "age"),
+//		func(name string, age int) User {
+//			return User{Name: name, Age: age}
+//		},
+//	)
+func Map2[A, B, T any](a Validated[A], b Validated[B], f func(A, B) T) Validated[T] {
+	if errs := mergeErrors(a.Errors(), b.Errors()); errs != nil {
+		return &validated[T]{errors: errs}
+	}
+
+	return Valid(f(a.Value(), b.Value()))
+}
+
+// Map3 is like Map2 for three validated values.
+func Map3[A, B, C, T any](a Validated[A], b Validated[B], c Validated[C], f func(A, B, C) T) Validated[T] {
+	if errs := mergeErrors(a.Errors(), b.Errors(), c.Errors()); errs != nil {
+		return &validated[T]{errors: errs}
+	}
+
+	return Valid(f(a.Value(), b.Value(), c.Value()))
+}
+
+// Map4 is like Map2 for four validated values.
+func Map4[A, B, C, D, T any](a Validated[A], b Validated[B], c Validated[C], d Validated[D], f func(A, B, C, D) T) Validated[T] {
+	if errs := mergeErrors(a.Errors(), b.Errors(), c.Errors(), d.Errors()); errs != nil {
+		return &validated[T]{errors: errs}
+	}
+
+	return Valid(f(a.Value(), b.Value(), c.Value(), d.Value()))
+}
+
+// Map5 is like Map2 for five validated values.
+func Map5[A, B, C, D, E, T any](a Validated[A], b Validated[B], c Validated[C], d Validated[D], e Validated[E], f func(A, B, C, D, E) T) Validated[T] {
+	if errs := mergeErrors(a.Errors(), b.Errors(), c.Errors(), d.Errors(), e.Errors()); errs != nil {
+		return &validated[T]{errors: errs}
+	}
+
+	return Valid(f(a.Value(), b.Value(), c.Value(), d.Value(), e.Value()))
+}
+
+func mergeErrors(errs ...MultiError) MultiError {
+	var merged MultiError
+	for _, e := range errs {
+		merged = append(merged, e...)
+	}
+
+	return merged
+}
+
+// keyPath returns the field path of an item error key, "[key]" for keys that are not already paths.
+func keyPath(key any) string {
+	if path, ok := key.(string); ok {
+		return path
+	}
+
+	return fmt.Sprintf("[%v]", key)
+}
+
+// joinPath joins a parent and a child field path, keeping index paths such as "[0]" attached.
+func joinPath(parent, child string) string {
+	switch {
+	case child == "":
+		return parent
+	case strings.HasPrefix(child, "["):
+		return parent + child
+	default:
+		return parent + "." + child
+	}
+}
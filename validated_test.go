@@ -0,0 +1,123 @@
+package goresult
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+var errTestEmpty = errors.New("must not be empty")
+
+func notEmpty(s string) error {
+	if s == "" {
+		return errTestEmpty
+	}
+	return nil
+}
+
+func maxLength(n int) func(string) error {
+	return func(s string) error {
+		if len(s) > n {
+			return fmt.Errorf("must be at most %d characters", n)
+		}
+		return nil
+	}
+}
+
+type testAccount struct {
+	Name string
+	Age  int
+}
+
+func validateAccount(name, age string) Validated[testAccount] {
+	return Map2(
+		Validate(name, "name", notEmpty, maxLength(3)),
+		ValidatedFrom(From(strconv.Atoi(age)), "age"),
+		func(name string, age int) testAccount {
+			return testAccount{Name: name, Age: age}
+		},
+	)
+}
+
+func Test_Validated_Valid(t *testing.T) {
+	v := validateAccount("bob", "18")
+
+	assert.True(t, v.IsValid())
+	assert.False(t, v.IsInvalid())
+	assert.Nil(t, v.Errors())
+	assert.Equal(t, testAccount{Name: "bob", Age: 18}, v.Unwrap())
+	assert.Equal(t, Ok(testAccount{Name: "bob", Age: 18}), v.Result())
+}
+
+func Test_Validated_Invalid(t *testing.T) {
+	v := validateAccount("", "x")
+
+	assert.True(t, v.IsInvalid())
+	assert.Len(t, v.Errors(), 2)
+	assert.Equal(t, "name", v.Errors()[0].Key)
+	assert.Equal(t, "age", v.Errors()[1].Key)
+	assert.Panics(t, func() { v.Unwrap() })
+
+	r := v.Result()
+	assert.ErrorIs(t, r.Error(), errTestEmpty)
+	assert.ErrorIs(t, r.Error(), strconv.ErrSyntax)
+	assert.Equal(t, `name: must not be empty; age: strconv.Atoi: parsing "x": invalid syntax`, r.Error().Error())
+}
+
+func Test_Validate(t *testing.T) {
+	v := Validate("", "name", notEmpty, maxLength(3))
+	assert.Len(t, v.Errors(), 1)
+
+	v = Validate("alice", "name", notEmpty, maxLength(3))
+	assert.EqualError(t, v.Errors(), "name: must be at most 3 characters")
+	assert.Equal(t, "alice", v.Value())
+}
+
+func Test_Validated_At(t *testing.T) {
+	v := validateAccount("", "18").At("accounts[0]")
+	assert.Equal(t, "accounts[0].name", v.Errors()[0].Key)
+
+	assert.Equal(t, "ports[1]", Invalid[int]("[1]", "error").At("ports").Errors()[0].Key)
+	assert.Equal(t, "port", Invalid[int]("", "error").At("port").Errors()[0].Key)
+
+	assert.Equal(t, Valid(1), Valid(1).At("port"))
+}
+
+func Test_ValidatedFrom(t *testing.T) {
+	assert.Equal(t, Valid(1), ValidatedFrom(Ok(1), "port"))
+	assert.EqualError(t, ValidatedFrom(Error[int]("error"), "port").Errors(), "port: error")
+
+	nested := ValidatedFrom(validateAccount("", "x").Result(), "owner")
+	assert.EqualError(t, nested.Errors(), `owner.name: must not be empty; owner.age: strconv.Atoi: parsing "x": invalid syntax`)
+
+	ports := ValidatedFrom(CollectAll([]Result[int]{Ok(80), Error[int]("error")}), "ports")
+	assert.EqualError(t, ports.Errors(), "ports[1]: error")
+}
+
+func Test_Validated_MapN(t *testing.T) {
+	sum3 := func(a, b, c int) int { return a + b + c }
+	sum4 := func(a, b, c, d int) int { return a + b + c + d }
+	sum5 := func(a, b, c, d, e int) int { return a + b + c + d + e }
+
+	assert.Equal(t, Valid(6), Map3(Valid(1), Valid(2), Valid(3), sum3))
+	assert.Equal(t, Valid(10), Map4(Valid(1), Valid(2), Valid(3), Valid(4), sum4))
+	assert.Equal(t, Valid(15), Map5(Valid(1), Valid(2), Valid(3), Valid(4), Valid(5), sum5))
+
+	v := Map5(Invalid[int]("a", "error"), Valid(2), Invalid[int]("c", "error"), Valid(4), Invalid[int]("e", "error"), sum5)
+	assert.EqualError(t, v.Errors(), "a: error; c: error; e: error")
+	assert.Len(t, Map4(Valid(1), Invalid[int]("b", "error"), Valid(3), Invalid[int]("d", "error"), sum4).Errors(), 2)
+	assert.Len(t, Map3(Invalid[int]("a", "error"), Valid(2), Valid(3), sum3).Errors(), 1)
+}
+
+func Test_Validated_ErrorsAs(t *testing.T) {
+	r := validateAccount("", "x").Result()
+
+	var ie *ItemError
+	assert.True(t, errors.As(r.Error(), &ie))
+	assert.Equal(t, "name", ie.Key)
+
+	var ne *strconv.NumError
+	assert.True(t, errors.As(r.Error(), &ne))
+}
@@ -0,0 +1,41 @@
+package value
+
+import "github.com/siriusa51/goresult"
+
+// FromOption converts an Option from the interface-based goresult package.
+// A nil opt converts to the zero Option, which is None.
+func FromOption[T any](opt goresult.Option[T]) Option[T] {
+	if opt == nil || opt.IsNone() {
+		return None[T]()
+	}
+
+	return Some(opt.Value())
+}
+
+// FromResult converts a Result from the interface-based goresult package.
+// A nil r converts to the zero Result, which is Ok with the zero value of T.
+func FromResult[T any](r goresult.Result[T]) Result[T] {
+	if r == nil {
+		return Result[T]{}
+	}
+
+	return Result[T]{value: r.Value(), error: r.Error()}
+}
+
+// Interface converts the option to the interface-based goresult package.
+func (opt Option[T]) Interface() goresult.Option[T] {
+	if opt.IsSome() {
+		return goresult.Some(opt.value)
+	}
+
+	return goresult.None[T]()
+}
+
+// Interface converts the result to the interface-based goresult package.
+func (r Result[T]) Interface() goresult.Result[T] {
+	if r.IsOk() {
+		return goresult.Ok(r.value)
+	}
+
+	return goresult.Error[T](r.error)
+}
@@ -0,0 +1,28 @@
+package value
+
+import (
+	"fmt"
+	"github.com/siriusa51/goresult"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_Migrate_Option(t *testing.T) {
+	assert.Equal(t, Some(1), FromOption(goresult.Some(1)))
+	assert.Equal(t, None[int](), FromOption(goresult.None[int]()))
+	assert.Equal(t, None[int](), FromOption[int](nil))
+
+	assert.Equal(t, goresult.Some(1), Some(1).Interface())
+	assert.Equal(t, goresult.None[int](), None[int]().Interface())
+}
+
+func Test_Migrate_Result(t *testing.T) {
+	err := fmt.Errorf("error")
+
+	assert.Equal(t, Ok(1), FromResult(goresult.Ok(1)))
+	assert.Equal(t, Error[int](err), FromResult(goresult.Error[int](err)))
+	assert.Equal(t, Result[int]{}, FromResult[int](nil))
+
+	assert.Equal(t, goresult.Ok(1), Ok(1).Interface())
+	assert.Equal(t, goresult.Error[int](err), Error[int](err).Interface())
+}
@@ -0,0 +1,124 @@
+package value
+
+// Option is an option type, it is either Some(T) or None.
+// It is passed by value and never allocates. The zero value of Option is None.
+type Option[T any] struct {
+	value T
+	some  bool
+}
+
+// Some returns an option value of Some(T).
+func Some[T any](value T) Option[T] {
+	return Option[T]{value: value, some: true}
+}
+
+// None returns an option value of None.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// Value return value
+// example:
+//
+//	opt := Some(1)
+//	fmt.Println(opt.Value())
+func (opt Option[T]) Value() T {
+	return opt.value
+}
+
+// IsSome returns true if the option is a Some value.
+func (opt Option[T]) IsSome() bool {
+	return opt.some
+}
+
+// IsNone returns true if the option is a nil value.
+func (opt Option[T]) IsNone() bool {
+	return !opt.some
+}
+
+// Unwrap returns the inner T of a Some(T). Panics if the self value equals nil.
+// example:
+//
+//	opt := Some(1)
+//	fmt.Println(opt.Unwrap())
+//	// Output: 1
+func (opt Option[T]) Unwrap() T {
+	if opt.IsNone() {
+		panic("called `option.Unwrap()` on a `nil` value")
+	}
+
+	return opt.value
+}
+
+// UnwrapOr returns the inner T of a Some(T). Returns defaults if the self value is nil.
+// example:
+//
+//	opt := None[int]()
+//	fmt.Println(opt.UnwrapOr(2))
+//	// Output: 2
+func (opt Option[T]) UnwrapOr(defaults T) T {
+	if opt.IsNone() {
+		return defaults
+	}
+
+	return opt.value
+}
+
+// UnwrapOrElse calls f if the self value is nil. Returns the inner T of a Some(T).
+func (opt Option[T]) UnwrapOrElse(f func() T) T {
+	if opt.IsNone() {
+		return f()
+	}
+
+	return opt.value
+}
+
+// UnwrapOrDefault returns the inner T of a Some(T). Returns the default value of T if the self value is nil.
+func (opt Option[T]) UnwrapOrDefault() T {
+	if opt.IsNone() {
+		var zero T
+		return zero
+	}
+
+	return opt.value
+}
+
+// Inspect calls f if the self value equals Some(T).
+func (opt Option[T]) Inspect(f func(T)) Option[T] {
+	if opt.IsSome() {
+		f(opt.value)
+	}
+
+	return opt
+}
+
+// OkOr returns an Ok(T) containing the inner T of a Some(T).
+// If the self value is nil, returns an Error(err) containing err.
+func (opt Option[T]) OkOr(err interface{}) Result[T] {
+	if opt.IsSome() {
+		return Ok(opt.value)
+	}
+
+	return Error[T](err)
+}
+
+// OkOrElse returns an Ok(T) containing the inner T of a Some(T).
+// If the self value is nil, calls f and returns an Error(err) containing the result.
+func (opt Option[T]) OkOrElse(f func() error) Result[T] {
+	if opt.IsSome() {
+		return Ok(opt.value)
+	}
+
+	return Error[T](f())
+}
+
+// Filter returns None if the self value equals None, otherwise calls predicate with the wrapped value and returns:
+//   - Some(T) if predicate returns true (where T is the wrapped value)
+//   - None() otherwise.
+func (opt Option[T]) Filter(predicate func(value T) bool) Option[T] {
+	if opt.IsSome() && predicate(opt.value) {
+		return opt
+	}
+
+	return None[T]()
+}
@@ -0,0 +1,83 @@
+package value
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_Option_Zero(t *testing.T) {
+	var opt Option[int]
+
+	assert.True(t, opt.IsNone())
+	assert.Equal(t, None[int](), opt)
+}
+
+func Test_Option_Some(t *testing.T) {
+	opt := Some(1)
+
+	assert.True(t, opt.IsSome())
+	assert.Equal(t, 1, opt.Value())
+	assert.Equal(t, 1, opt.Unwrap())
+}
+
+func Test_Option_Unwrap_None(t *testing.T) {
+	assert.Panics(t, func() { None[int]().Unwrap() })
+}
+
+func Test_Option_UnwrapOr(t *testing.T) {
+	assert.Equal(t, 1, Some(1).UnwrapOr(2))
+	assert.Equal(t, 2, None[int]().UnwrapOr(2))
+	assert.Equal(t, 2, None[int]().UnwrapOrElse(func() int { return 2 }))
+	assert.Equal(t, 0, None[int]().UnwrapOrDefault())
+}
+
+func Test_Option_Inspect(t *testing.T) {
+	var result int
+	Some(1).Inspect(func(i int) { result = i })
+	assert.Equal(t, 1, result)
+
+	result = 0
+	None[int]().Inspect(func(i int) { result = i })
+	assert.Equal(t, 0, result)
+}
+
+func Test_Option_OkOr(t *testing.T) {
+	err := fmt.Errorf("error")
+
+	assert.Equal(t, Ok(1), Some(1).OkOr(err))
+	assert.Equal(t, Error[int](err), None[int]().OkOr(err))
+	assert.Equal(t, Error[int](err), None[int]().OkOrElse(func() error { return err }))
+}
+
+func Test_Option_Filter(t *testing.T) {
+	even := func(i int) bool { return i%2 == 0 }
+
+	assert.Equal(t, Some(2), Some(2).Filter(even))
+	assert.Equal(t, None[int](), Some(1).Filter(even))
+}
+
+func Test_Option_Allocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		opt := Some(1)
+		_ = opt.Unwrap()
+		_ = None[int]().UnwrapOr(2)
+		_ = opt.OkOr(nil).Unwrap()
+	})
+
+	assert.Equal(t, 0.0, allocs)
+}
+
+func Benchmark_Option_Some(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Some(i).Unwrap()
+	}
+}
+
+func Benchmark_Option_None(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = None[int]().UnwrapOr(i)
+	}
+}
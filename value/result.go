@@ -0,0 +1,176 @@
+package value
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Result is a generic type that represents either success (Ok) or failure (Error).
+// It is passed by value and never allocates. The zero value of Result is Ok with the zero value of T.
+type Result[T any] struct {
+	value T
+	error error
+}
+
+// Ok returns a result that is Ok.
+// example:
+//
+//	Ok(1)
+//	Ok("hello")
+func Ok[T any](value T) Result[T] {
+	return Result[T]{value: value}
+}
+
+// Error returns a result that is Error.
+// example:
+//
+//	Error[int](errors.New("something went wrong"))
+//	Error[string]("something went wrong")
+func Error[T any](err interface{}) Result[T] {
+	return Result[T]{error: covertError(err)}
+}
+
+// From returns Ok(value) if err is nil, otherwise Error(err).
+// example:
+//
+//	From(strconv.Atoi("1"))
+func From[T any](value T, err error) Result[T] {
+	if err != nil {
+		return Result[T]{error: err}
+	}
+
+	return Result[T]{value: value}
+}
+
+// Value return value
+func (r Result[T]) Value() T {
+	return r.value
+}
+
+// ToAny returns the value to any type.
+func (r Result[T]) ToAny() any {
+	return r.value
+}
+
+// Error return error
+func (r Result[T]) Error() error {
+	return r.error
+}
+
+// IsOk returns true if the result is Ok.
+func (r Result[T]) IsOk() bool {
+	return r.error == nil
+}
+
+// IsError returns true if the result is Error.
+func (r Result[T]) IsError() bool {
+	return r.error != nil
+}
+
+// Get returns the value and the error as a (T, error) pair.
+func (r Result[T]) Get() (T, error) {
+	return r.value, r.error
+}
+
+// Except returns the value if the result is Ok, otherwise it panics with the given message.
+func (r Result[T]) Except(msg string) T {
+	if r.IsError() {
+		unwrapErrorFailed(msg, r.error)
+	}
+
+	return r.value
+}
+
+// ExceptError returns the error if the result is Error, otherwise it panics with the given message.
+func (r Result[T]) ExceptError(msg string) error {
+	if r.IsOk() {
+		unwrapValueFailed(msg, r.value)
+	}
+
+	return r.error
+}
+
+// Inspect calls the given function with the value if the result is Ok.
+func (r Result[T]) Inspect(f func(T)) Result[T] {
+	if r.IsOk() {
+		f(r.value)
+	}
+
+	return r
+}
+
+// InspectError calls the given function with the error if the result is Error.
+func (r Result[T]) InspectError(f func(error)) Result[T] {
+	if r.IsError() {
+		f(r.error)
+	}
+
+	return r
+}
+
+// Unwrap returns the value if the result is Ok, otherwise it panics.
+func (r Result[T]) Unwrap() T {
+	return r.Except("called `result.Unwrap()` on an `error` value")
+}
+
+// UnwrapError returns the error if the result is Error, otherwise it panics.
+func (r Result[T]) UnwrapError() error {
+	return r.ExceptError("called `result.UnwrapError()` on an `value` value")
+}
+
+// UnwrapOr returns the value if the result is Ok, otherwise it returns the given default.
+func (r Result[T]) UnwrapOr(defaults T) T {
+	if r.IsOk() {
+		return r.value
+	}
+
+	return defaults
+}
+
+// UnwrapOrDefault returns the value if the result is Ok, otherwise it returns the default value of the type.
+func (r Result[T]) UnwrapOrDefault() T {
+	if r.IsOk() {
+		return r.value
+	}
+
+	var zero T
+	return zero
+}
+
+// UnwrapOrElse returns the value if the result is Ok, otherwise it calls and returns the given function.
+func (r Result[T]) UnwrapOrElse(f func() T) T {
+	if r.IsOk() {
+		return r.value
+	}
+
+	return f()
+}
+
+// Option returns the value as an option.
+// - If the result is Ok, the returned option will be Some(T) with the value.
+// - If the result is Error, the returned option will be None().
+func (r Result[T]) Option() Option[T] {
+	if r.IsOk() {
+		return Some(r.value)
+	}
+
+	return None[T]()
+}
+
+func unwrapErrorFailed(msg string, err error) {
+	panic(fmt.Errorf("%s: %s", msg, err.Error()))
+}
+
+func unwrapValueFailed[T any](msg string, value T) {
+	types := reflect.TypeOf(value).String()
+	panic(fmt.Errorf("%s: %s", msg, types))
+}
+
+func covertError(err interface{}) error {
+	switch err := err.(type) {
+	case error:
+		return err
+	default:
+		return fmt.Errorf("%v", err)
+	}
+}
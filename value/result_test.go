@@ -0,0 +1,88 @@
+package value
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func Test_Result_Zero(t *testing.T) {
+	var r Result[int]
+
+	assert.True(t, r.IsOk())
+	assert.Equal(t, 0, r.Unwrap())
+}
+
+func Test_Result_Ok(t *testing.T) {
+	r := Ok(42)
+
+	assert.True(t, r.IsOk())
+	assert.False(t, r.IsError())
+	assert.Equal(t, 42, r.Value())
+	assert.Equal(t, 42, r.ToAny())
+	assert.Nil(t, r.Error())
+	assert.Panics(t, func() { _ = r.UnwrapError() })
+}
+
+func Test_Result_Error(t *testing.T) {
+	r := Error[int]("error")
+
+	assert.True(t, r.IsError())
+	assert.EqualError(t, r.UnwrapError(), "error")
+	assert.Panics(t, func() { r.Unwrap() })
+	assert.Equal(t, 1, r.UnwrapOr(1))
+	assert.Equal(t, 0, r.UnwrapOrDefault())
+	assert.Equal(t, 2, r.UnwrapOrElse(func() int { return 2 }))
+}
+
+func Test_Result_From(t *testing.T) {
+	assert.Equal(t, Ok(42), From(strconv.Atoi("42")))
+	assert.True(t, From(strconv.Atoi("hello")).IsError())
+
+	v, err := Ok(42).Get()
+	assert.Equal(t, 42, v)
+	assert.Nil(t, err)
+}
+
+func Test_Result_Inspect(t *testing.T) {
+	count := 0
+	Ok(1).Inspect(func(int) { count++ }).InspectError(func(error) { count++ })
+	Error[int]("error").Inspect(func(int) { count++ }).InspectError(func(error) { count++ })
+
+	assert.Equal(t, 2, count)
+}
+
+func Test_Result_Option(t *testing.T) {
+	assert.Equal(t, Some(42), Ok(42).Option())
+	assert.Equal(t, None[int](), Error[int]("error").Option())
+}
+
+func Test_Result_Allocs(t *testing.T) {
+	err := errors.New("error")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = Ok(1).Unwrap()
+		_ = Error[int](err).UnwrapOr(2)
+		_ = From(1, nil).Option().IsSome()
+	})
+
+	assert.Equal(t, 0.0, allocs)
+}
+
+func Benchmark_Result_Ok(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Ok(i).Unwrap()
+	}
+}
+
+func Benchmark_Result_Error(b *testing.B) {
+	err := fmt.Errorf("error")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Error[int](err).UnwrapOr(i)
+	}
+}